@@ -233,7 +233,7 @@ class MLflowDeployer:
         cmd = (
             f"cd {quoted_repo_root} && "
             f"export NAMESPACE={quoted_namespace} && "
-            f"kustomize build {quoted_kind_overlay} | envsubst | kubectl apply -f -"
+            f"kustomize build {quoted_kind_overlay} | envsubst | kubectl apply --server-side --force-conflicts -f -"
         )
         self.run_command(cmd, "Deploying MLflow operator")
 
@@ -20,14 +20,17 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // MLflowSpec defines the desired state of MLflow
 // +kubebuilder:validation:XValidation:rule="has(self.defaultArtifactRoot) || (has(self.serveArtifacts) && self.serveArtifacts)",message="defaultArtifactRoot must be set when serveArtifacts is not true"
 // +kubebuilder:validation:XValidation:rule="!has(self.defaultArtifactRoot) || !self.defaultArtifactRoot.startsWith('file://') || (has(self.serveArtifacts) && self.serveArtifacts)",message="serveArtifacts must be enabled when defaultArtifactRoot uses file-based storage (file:// prefix)"
-// +kubebuilder:validation:XValidation:rule="(has(self.backendStoreUri) && size(self.backendStoreUri) > 0) || (has(self.backendStoreUriFrom) && size(self.backendStoreUriFrom.name) > 0 && size(self.backendStoreUriFrom.key) > 0)",message="backendStoreUri or backendStoreUriFrom must be set"
+// +kubebuilder:validation:XValidation:rule="(has(self.backendStoreUri) && size(self.backendStoreUri) > 0) || (has(self.backendStoreUriFrom) && size(self.backendStoreUriFrom.name) > 0 && size(self.backendStoreUriFrom.key) > 0) || (has(self.database) && has(self.database.connection))",message="backendStoreUri, backendStoreUriFrom or database.connection must be set"
 // +kubebuilder:validation:XValidation:rule="!(has(self.backendStoreUri) && has(self.backendStoreUriFrom))",message="backendStoreUri and backendStoreUriFrom are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.database) && has(self.database.connection) && (has(self.backendStoreUri) || has(self.backendStoreUriFrom)))",message="database.connection is mutually exclusive with backendStoreUri and backendStoreUriFrom"
 // +kubebuilder:validation:XValidation:rule="!(has(self.readReplicaBackendStoreUri) && has(self.readReplicaBackendStoreUriFrom))",message="readReplicaBackendStoreUri and readReplicaBackendStoreUriFrom are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="!has(self.readReplicaBackendStoreUriFrom) || (size(self.readReplicaBackendStoreUriFrom.name) > 0 && size(self.readReplicaBackendStoreUriFrom.key) > 0)",message="readReplicaBackendStoreUriFrom.name and readReplicaBackendStoreUriFrom.key must be non-empty when readReplicaBackendStoreUriFrom is set"
 // +kubebuilder:validation:XValidation:rule="!(has(self.registryStoreUri) && has(self.registryStoreUriFrom))",message="registryStoreUri and registryStoreUriFrom are mutually exclusive"
@@ -35,20 +38,39 @@ import (
 // +kubebuilder:validation:XValidation:rule="!has(self.backendStoreUri) || self.backendStoreUri.startsWith('sqlite://') || self.backendStoreUri.startsWith('sqlite+') || self.backendStoreUri.startsWith('postgresql://') || self.backendStoreUri.startsWith('postgresql+') || self.backendStoreUri.startsWith('mysql://') || self.backendStoreUri.startsWith('mysql+')",message="backendStoreUri must use a supported SQL metadata store URI scheme"
 // +kubebuilder:validation:XValidation:rule="!has(self.readReplicaBackendStoreUri) || self.readReplicaBackendStoreUri.startsWith('sqlite://') || self.readReplicaBackendStoreUri.startsWith('sqlite+') || self.readReplicaBackendStoreUri.startsWith('postgresql://') || self.readReplicaBackendStoreUri.startsWith('postgresql+') || self.readReplicaBackendStoreUri.startsWith('mysql://') || self.readReplicaBackendStoreUri.startsWith('mysql+')",message="readReplicaBackendStoreUri must use a supported SQL metadata store URI scheme"
 // +kubebuilder:validation:XValidation:rule="!has(self.registryStoreUri) || self.registryStoreUri.startsWith('sqlite://') || self.registryStoreUri.startsWith('sqlite+') || self.registryStoreUri.startsWith('postgresql://') || self.registryStoreUri.startsWith('postgresql+') || self.registryStoreUri.startsWith('mysql://') || self.registryStoreUri.startsWith('mysql+')",message="registryStoreUri must use a supported SQL metadata store URI scheme"
-// +kubebuilder:validation:XValidation:rule="!has(self.backendStoreUri) || (!self.backendStoreUri.startsWith('sqlite://') && !self.backendStoreUri.startsWith('file://')) || has(self.storage)",message="storage must be configured when using file-based backend store (sqlite:// or file:// prefix)"
-// +kubebuilder:validation:XValidation:rule="!has(self.readReplicaBackendStoreUri) || !self.readReplicaBackendStoreUri.startsWith('sqlite://') || has(self.storage)",message="storage must be configured when using a file-based read-replica backend store (sqlite:// prefix)"
-// +kubebuilder:validation:XValidation:rule="!has(self.registryStoreUri) || (!self.registryStoreUri.startsWith('sqlite://') && !self.registryStoreUri.startsWith('file://')) || has(self.storage)",message="storage must be configured when using file-based registry store (sqlite:// or file:// prefix)"
-// +kubebuilder:validation:XValidation:rule="!has(self.artifactsDestination) || !self.artifactsDestination.startsWith('file://') || has(self.storage)",message="storage must be configured when artifactsDestination uses file-based storage (file:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!has(self.backendStoreUri) || (!self.backendStoreUri.startsWith('sqlite://') && !self.backendStoreUri.startsWith('file://')) || has(self.storage) || has(self.existingStorageClaim)",message="storage must be configured when using file-based backend store (sqlite:// or file:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!has(self.readReplicaBackendStoreUri) || !self.readReplicaBackendStoreUri.startsWith('sqlite://') || has(self.storage) || has(self.existingStorageClaim)",message="storage must be configured when using a file-based read-replica backend store (sqlite:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!has(self.registryStoreUri) || (!self.registryStoreUri.startsWith('sqlite://') && !self.registryStoreUri.startsWith('file://')) || has(self.storage) || has(self.existingStorageClaim)",message="storage must be configured when using file-based registry store (sqlite:// or file:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!((has(self.backendStoreUri) && self.backendStoreUri.startsWith('sqlite')) || (has(self.registryStoreUri) && self.registryStoreUri.startsWith('sqlite'))) || ((!has(self.replicas) || self.replicas <= 1) && (!has(self.autoscaling) || !has(self.autoscaling.enabled) || !self.autoscaling.enabled || self.autoscaling.maxReplicas <= 1))",message="replicas and autoscaling.maxReplicas must be 1 with a SQLite backend or registry store; use a postgresql:// or mysql:// store to run more replicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsDestination) || !self.artifactsDestination.startsWith('file://') || has(self.storage) || has(self.existingStorageClaim)",message="storage must be configured when artifactsDestination uses file-based storage (file:// prefix)"
 // +kubebuilder:validation:XValidation:rule="!has(self.artifactsDestination) || !self.artifactsDestination.startsWith('file://') || (has(self.serveArtifacts) && self.serveArtifacts)",message="serveArtifacts must be enabled when artifactsDestination uses file-based storage (file:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!(has(self.artifactsDestination) && has(self.artifactsDestinationFrom))",message="artifactsDestination and artifactsDestinationFrom are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsDestinationFrom) || (size(self.artifactsDestinationFrom.name) > 0 && size(self.artifactsDestinationFrom.key) > 0)",message="artifactsDestinationFrom.name and artifactsDestinationFrom.key must be non-empty when artifactsDestinationFrom is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.storageClaimName) || has(self.storage)",message="storage must be configured when storageClaimName is set"
+// +kubebuilder:validation:XValidation:rule="!(has(self.storageClaimName) && has(self.existingStorageClaim))",message="storageClaimName and existingStorageClaim are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsSubPath) || !has(self.artifactsDestinationFrom)",message="artifactsSubPath cannot be combined with artifactsDestinationFrom"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsSubPath) || has(self.storage) || has(self.existingStorageClaim)",message="storage must be configured when artifactsSubPath is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsSubPath) || (has(self.serveArtifacts) && self.serveArtifacts)",message="serveArtifacts must be enabled when artifactsSubPath is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsSubPath) || !has(self.artifactsDestination) || self.artifactsDestination.startsWith('file://')",message="artifactsSubPath can only be used with file-based artifactsDestination (file:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsReadOnly) || !self.artifactsReadOnly || has(self.artifactsSubPath)",message="artifactsSubPath must be set when artifactsReadOnly is true"
+// +kubebuilder:validation:XValidation:rule="!has(self.artifactsReadOnly) || !self.artifactsReadOnly || (has(self.storage) && has(self.storage.accessModes) && size(self.storage.accessModes) > 0 && self.storage.accessModes[0] in ['ReadWriteMany', 'ReadOnlyMany'])",message="storage.accessModes must start with ReadWriteMany or ReadOnlyMany when artifactsReadOnly is true"
+// +kubebuilder:validation:XValidation:rule="!has(self.storage) || !has(self.storage.accessModes) || !('ReadOnlyMany' in self.storage.accessModes) || ((has(self.backendStoreUriFrom) || (has(self.database) && has(self.database.connection)) || (has(self.backendStoreUri) && !self.backendStoreUri.startsWith('sqlite') && !self.backendStoreUri.startsWith('file://'))) && (!has(self.registryStoreUri) || (!self.registryStoreUri.startsWith('sqlite') && !self.registryStoreUri.startsWith('file://'))) && (!has(self.serveArtifacts) || !self.serveArtifacts || has(self.artifactsDestinationFrom) || (has(self.artifactsDestination) && !self.artifactsDestination.startsWith('file://')) || (has(self.artifactsReadOnly) && self.artifactsReadOnly)))",message="storage.accessModes may only include ReadOnlyMany with a remote backend and registry store and a remote artifactsDestination (or artifactsReadOnly); the server cannot write to a read-only volume"
 // +kubebuilder:validation:XValidation:rule="!has(self.env) || self.env.all(e, e.name != 'MLFLOW_SERVER_DISABLE_SECURITY_MIDDLEWARE')",message="setting the MLFLOW_SERVER_DISABLE_SECURITY_MIDDLEWARE environment variable is not allowed"
 // +kubebuilder:validation:XValidation:rule="!has(self.env) || self.env.all(e, e.name != 'MLFLOW_SERVER_ENABLE_JOB_EXECUTION')",message="setting the MLFLOW_SERVER_ENABLE_JOB_EXECUTION environment variable is not allowed; the operator manages job execution lifecycle"
 // +kubebuilder:validation:XValidation:rule="!has(self.networkPolicyEgressRules) || self.networkPolicyEgressRules.all(r, (has(r.ports) && size(r.ports) > 0) || (has(r.to) && size(r.to) > 0))",message="each networkPolicyEgressRules entry must specify at least one port or one destination"
 // +kubebuilder:validation:XValidation:rule="!has(self.networkPolicyAdditionalEgressRules) || self.networkPolicyAdditionalEgressRules.all(r, (has(r.ports) && size(r.ports) > 0) || (has(r.to) && size(r.to) > 0))",message="each networkPolicyAdditionalEgressRules entry must specify at least one port or one destination"
+// +kubebuilder:validation:XValidation:rule="!has(self.networkPolicy) || !has(self.networkPolicy.restrictEgress) || !self.networkPolicy.restrictEgress || !has(self.networkPolicyEgressRules) || size(self.networkPolicyEgressRules) == 0",message="networkPolicy.restrictEgress cannot be combined with networkPolicyEgressRules"
 // +kubebuilder:validation:XValidation:rule="!has(self.resourceClaims) || self.resourceClaims.all(c, ((has(c.resourceClaimName) && size(c.resourceClaimName) > 0) != (has(c.resourceClaimTemplateName) && size(c.resourceClaimTemplateName) > 0)))",message="each resourceClaims entry must set exactly one non-empty value: resourceClaimName or resourceClaimTemplateName"
-// +kubebuilder:validation:XValidation:rule="!has(self.traceArchival) || !has(self.traceArchival.location) || !self.traceArchival.location.startsWith('file://') || has(self.storage)",message="storage must be configured when traceArchival.location uses file-based storage (file:// prefix)"
+// +kubebuilder:validation:XValidation:rule="!has(self.traceArchival) || !has(self.traceArchival.location) || !self.traceArchival.location.startsWith('file://') || has(self.storage) || has(self.existingStorageClaim)",message="storage must be configured when traceArchival.location uses file-based storage (file:// prefix)"
 // +kubebuilder:validation:XValidation:rule="!has(self.traceArchival) || !has(self.traceArchival.enabled) || self.traceArchival.enabled == false || (has(self.traceArchival.schedule) && size(self.traceArchival.schedule) > 0)",message="traceArchival.schedule is required when traceArchival.enabled is true"
 // +kubebuilder:validation:XValidation:rule="!has(self.traceArchival) || !has(self.traceArchival.enabled) || self.traceArchival.enabled == false || (has(self.traceArchival.location) && size(self.traceArchival.location) > 0)",message="traceArchival.location is required when traceArchival.enabled is true"
 // +kubebuilder:validation:XValidation:rule="!has(self.traceArchival) || !has(self.traceArchival.enabled) || self.traceArchival.enabled == false || (has(self.traceArchival.retention) && size(self.traceArchival.retention) > 0)",message="traceArchival.retention is required when traceArchival.enabled is true"
+// +kubebuilder:validation:XValidation:rule="!has(self.networkPolicy) || !has(self.networkPolicy.ingressFrom) || size(self.networkPolicy.ingressFrom) == 0 || !has(self.service) || !has(self.service.type) || self.service.type == 'ClusterIP'",message="networkPolicy.ingressFrom requires a ClusterIP service type"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceAccountToken) || !has(self.serviceAccountToken.containers) || self.serviceAccountToken.containers.all(n, has(self.extraContainers) && self.extraContainers.exists(c, c.name == n))",message="serviceAccountToken.containers must name spec.extraContainers entries"
+// +kubebuilder:validation:XValidation:rule="!has(self.serverTimeouts) || !has(self.serverTimeouts.gracefulShutdownSeconds) || self.serverTimeouts.gracefulShutdownSeconds + (has(self.shutdownDelaySeconds) ? self.shutdownDelaySeconds : 5) < (has(self.terminationGracePeriodSeconds) ? self.terminationGracePeriodSeconds : 30)",message="serverTimeouts.gracefulShutdownSeconds plus shutdownDelaySeconds must stay below terminationGracePeriodSeconds (default 30)"
+// +kubebuilder:validation:XValidation:rule="!has(self.shutdownDelaySeconds) || self.shutdownDelaySeconds == 0 || self.shutdownDelaySeconds < (has(self.terminationGracePeriodSeconds) ? self.terminationGracePeriodSeconds : 30)",message="shutdownDelaySeconds must stay below terminationGracePeriodSeconds (default 30)"
+// +kubebuilder:validation:XValidation:rule="!has(self.tls) || !has(self.tls.certManager) || !has(self.tls.certManager.enabled) || !self.tls.certManager.enabled || !has(self.openShift) || !has(self.openShift.route) || !has(self.openShift.route.tlsTermination) || self.openShift.route.tlsTermination == 'passthrough'",message="openShift.route.tlsTermination must be passthrough when tls.certManager is enabled; the router cannot verify a cert-manager backend certificate when re-encrypting"
+// +kubebuilder:validation:XValidation:rule="!has(self.database) || !(has(self.database.poolSize) || has(self.database.maxOverflow) || has(self.database.poolRecycleSeconds)) || has(self.backendStoreUriFrom) || has(self.database.connection) || (has(self.backendStoreUri) && !self.backendStoreUri.startsWith('sqlite'))",message="database pool settings require a database server backend store, not SQLite"
 type MLflowSpec struct {
 	// Image specifies the MLflow container image.
 	// If not specified, use the default image
@@ -56,12 +78,26 @@ type MLflowSpec struct {
 	// +optional
 	Image *ImageConfig `json:"image,omitempty"`
 
-	// Replicas is the number of MLflow pods to run
+	// Replicas is the number of MLflow pods to run. It must be 1 with a SQLite
+	// backend or registry store, because several pods writing one SQLite file
+	// corrupt the database.
 	// +kubebuilder:default=1
 	// +kubebuilder:validation:Minimum=1
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// Autoscaling creates a HorizontalPodAutoscaler for the MLflow Deployment.
+	// When enabled, the HorizontalPodAutoscaler owns the replica count and
+	// Replicas is ignored.
+	// +optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+
+	// PodDisruptionBudget creates a PodDisruptionBudget for the MLflow pods so
+	// voluntary disruptions such as node drains keep the server available.
+	// Multi-replica Deployments get one automatically unless enabled is false.
+	// +optional
+	PodDisruptionBudget *PDBConfig `json:"podDisruptionBudget,omitempty"`
+
 	// Migration controls operator-managed database migration orchestration.
 	// Add the presence-based mlflow.opendatahub.io/force-migrate annotation to
 	// trigger a one-shot rerun; the annotation value is ignored. If a finished
@@ -96,6 +132,56 @@ type MLflowSpec struct {
 	// +optional
 	Storage *corev1.PersistentVolumeClaimSpec `json:"storage,omitempty"`
 
+	// ExistingStorageClaim names a PersistentVolumeClaim in the target
+	// namespace, for example a shared ReadWriteMany volume provisioned out of
+	// band. The operator mounts it wherever it would mount its own PVC and
+	// does not create one. It satisfies every requirement for Storage. Storage
+	// may still be set to declare the claim's access modes, which choose the
+	// Deployment strategy and gate artifactsReadOnly. Its size and storage
+	// class are ignored, and the StorageSizeIgnored condition reports them.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	ExistingStorageClaim *string `json:"existingStorageClaim,omitempty"`
+
+	// StorageClaimName overrides the name of the PVC the operator creates
+	// from Storage, which defaults to "mlflow-pvc". A predictable name lets
+	// a reinstalled operator pick up a PVC kept from an earlier install: an
+	// existing PVC with this name is mounted as is. Changing the name on a
+	// running instance mounts a new, empty PVC.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	StorageClaimName *string `json:"storageClaimName,omitempty"`
+
+	// ArtifactsSubPath stores file-based artifacts in a subdirectory of the
+	// Storage PVC. The PVC is mounted a second time at /mlflow-artifacts using
+	// this subPath. When artifactsDestination is unset it defaults to
+	// file:///mlflow-artifacts; an explicit file:// destination is kept as set.
+	// Requires Storage or ExistingStorageClaim, and serveArtifacts, and can only be combined with an unset
+	// or file:// artifactsDestination.
+	// Example: "artifacts"
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9._-]+(/[A-Za-z0-9._-]+)*$`
+	// +kubebuilder:validation:XValidation:rule="!self.matches('(^|/)[.][.]?(/|$)')",message="artifactsSubPath must not contain '.' or '..' path segments"
+	// +optional
+	ArtifactsSubPath *string `json:"artifactsSubPath,omitempty"`
+
+	// ArtifactsReadOnly mounts the artifactsSubPath mount read-only so that
+	// replicas can share one ReadWriteMany or ReadOnlyMany PVC for
+	// download-heavy artifact serving. Artifact uploads through this server
+	// fail while it is set; write artifacts from a separate instance. With a
+	// ReadOnlyMany PVC the whole volume is mounted read-only, so the backend
+	// and registry stores must be remote; CRD validation rejects ReadOnlyMany
+	// otherwise. The Deployment uses a rolling update because
+	// the volume can be attached to several pods at once.
+	// Requires artifactsSubPath and a storage access mode of ReadWriteMany or
+	// ReadOnlyMany. Whether the storage class supports that mode is checked
+	// when the PVC is bound.
+	// +optional
+	ArtifactsReadOnly *bool `json:"artifactsReadOnly,omitempty"`
+
 	// BackendStoreURI is the URI for the MLflow backend store (metadata).
 	// Inline backendStoreUri values intentionally support only sqlite:// and
 	// postgresql://.
@@ -113,6 +199,14 @@ type MLflowSpec struct {
 	// +optional
 	BackendStoreURIFrom *corev1.SecretKeySelector `json:"backendStoreUriFrom,omitempty"`
 
+	// Database configures the backend store database: its connection as
+	// individual fields instead of a URI, the readiness check and the
+	// connection pool. Database.Connection is mutually exclusive with
+	// BackendStoreURI and BackendStoreURIFrom. The registry store defaults to
+	// the same database.
+	// +optional
+	Database *DatabaseSpec `json:"database,omitempty"`
+
 	// ReadReplicaBackendStoreURI is the optional URI for a read-only replica of the
 	// backend store. MLflow routes supported read operations to this database while
 	// writes and operator-managed migrations continue to use the primary backend store.
@@ -167,6 +261,16 @@ type MLflowSpec struct {
 	// +optional
 	ArtifactsDestination *string `json:"artifactsDestination,omitempty"`
 
+	// ArtifactsDestinationFrom is a reference to a secret containing the
+	// artifacts destination. Use this instead of ArtifactsDestination when the
+	// URI contains credentials or other values that should not be stored in
+	// the spec. Like ArtifactsDestination, it only applies when ServeArtifacts
+	// is enabled. The operator cannot read the URI, so it must point to remote
+	// storage and cannot be combined with ArtifactsSubPath.
+	// Mutually exclusive with ArtifactsDestination - the API rejects specs that set both.
+	// +optional
+	ArtifactsDestinationFrom *corev1.SecretKeySelector `json:"artifactsDestinationFrom,omitempty"`
+
 	// DefaultArtifactRoot is the default artifact root path for MLflow runs on the server.
 	// This is required when serveArtifacts is false.
 	// Supported schemes: file://, s3://, gs://, wasbs://, hdfs://, etc.
@@ -177,6 +281,11 @@ type MLflowSpec struct {
 	// +optional
 	DefaultArtifactRoot *string `json:"defaultArtifactRoot,omitempty"`
 
+	// ArtifactStore holds client settings for the artifact store backing
+	// artifactsDestination or defaultArtifactRoot.
+	// +optional
+	ArtifactStore *ArtifactStoreSpec `json:"artifactStore,omitempty"`
+
 	// ServeArtifacts determines whether MLflow should serve artifacts.
 	// When enabled, adds the --serve-artifacts flag to the MLflow server and uses ArtifactsDestination
 	// to configure where artifacts are stored. This allows clients to log and retrieve artifacts
@@ -188,12 +297,159 @@ type MLflowSpec struct {
 
 	// Workers is the number of uvicorn worker processes for the MLflow server.
 	// Note: This is different from pod replicas. Each pod will run this many worker processes.
-	// Defaults to 1. For high-traffic deployments, consider increasing pod replicas instead.
-	// +kubebuilder:default=1
+	// When unset, it is derived from resources.requests.cpu as 2*cores+1, capped at 8,
+	// and defaults to 1 without a CPU request. For high-traffic deployments, consider
+	// increasing pod replicas instead.
 	// +kubebuilder:validation:Minimum=1
 	// +optional
 	Workers *int32 `json:"workers,omitempty"`
 
+	// MaxRequestHeaderBytes caps the size of a request's line plus headers, in
+	// bytes. Raise it when a gateway forwards large headers such as long
+	// bearer tokens, which otherwise fail with 400 responses. It maps to
+	// uvicorn's --h11-max-incomplete-event-size and applies to the h11 HTTP
+	// implementation. When unset, uvicorn's default of 16 KiB applies.
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=1048576
+	// +optional
+	MaxRequestHeaderBytes *int32 `json:"maxRequestHeaderBytes,omitempty"`
+
+	// ServerTimeouts tunes the uvicorn connection timeouts of the MLflow
+	// server. They are passed in --uvicorn-opts and apply to every worker.
+	// +optional
+	ServerTimeouts *ServerTimeoutsConfig `json:"serverTimeouts,omitempty"`
+
+	// Logging configures the MLflow server logs.
+	// +optional
+	Logging *LoggingConfig `json:"logging,omitempty"`
+
+	// EffectiveConfig publishes the effective MLflow server settings in an
+	// mlflow-effective-config ConfigMap for debugging and audit. The ConfigMap
+	// is not mounted. Credentials in store URIs are redacted, and values read
+	// from Secrets only name the Secret and key.
+	// +optional
+	EffectiveConfig *EffectiveConfigSpec `json:"effectiveConfig,omitempty"`
+
+	// BasePath is the URL path MLflow is served under, for example /tracking
+	// behind a gateway that forwards that path unchanged. It sets
+	// --static-prefix for the server, and the probe paths, the
+	// operator-managed HTTPRoute prefix, the status URLs and the console link
+	// follow it.
+	// When unset, MLflow is served under /mlflow.
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^(/[A-Za-z0-9._~-]+)+$`
+	// +optional
+	BasePath *string `json:"basePath,omitempty"`
+
+	// ExtraArgs are additional command-line arguments for `mlflow server`.
+	// They are appended, in order, after the operator-managed flags. Each
+	// entry is passed as one argument without shell word splitting.
+	// Arguments that repeat a managed flag such as --host or --port are not
+	// filtered and may break the deployment.
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=atomic
+	// +optional
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+
+	// TmpVolumeSizeLimit is the size limit of the emptyDir volume mounted at /tmp in the
+	// MLflow container. Uvicorn workers and MLflow spool temporary files there because the
+	// root filesystem is read-only. Defaults to 128Mi.
+	// +optional
+	TmpVolumeSizeLimit *resource.Quantity `json:"tmpVolumeSizeLimit,omitempty"`
+
+	// Auth configures how the MLflow kubernetes-auth app authorizes requests.
+	// +optional
+	Auth *AuthConfig `json:"auth,omitempty"`
+
+	// ClientToken makes the operator maintain a long-lived bearer token Secret
+	// for programmatic MLflow clients. The token belongs to a dedicated
+	// mlflow-client-sa ServiceAccount that has no permissions of its own; bind
+	// it to the mlflow-view or mlflow-edit ClusterRole in each workspace it
+	// needs. When omitted, the client ServiceAccount and Secret are removed.
+	// +optional
+	ClientToken *ClientTokenSpec `json:"clientToken,omitempty"`
+
+	// ShutdownDelaySeconds is how long the MLflow container waits in a preStop
+	// hook before receiving SIGTERM. The delay lets Services and the gateway stop
+	// routing to a terminating pod before the server closes connections, which
+	// avoids 502 responses during rollouts. Set to 0 to disable. Must stay below
+	// TerminationGracePeriodSeconds.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3599
+	// +optional
+	ShutdownDelaySeconds *int32 `json:"shutdownDelaySeconds,omitempty"`
+
+	// TerminationGracePeriodSeconds is how long Kubernetes waits after
+	// starting to stop an MLflow pod before it kills the server. It covers the
+	// preStop delay and the time workers spend draining in-flight requests,
+	// so raise it for long artifact uploads. When unset, the Kubernetes
+	// default of 30 seconds applies. Migration Jobs keep the default.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// TerminationMessagePolicy is set on every operator-managed container,
+	// including init containers, CronJob containers and the migration Job.
+	// FallbackToLogsOnError uses the tail of the container log as the
+	// termination message when a container fails without writing one, so the
+	// failure reason shows up in kubectl describe. Defaults to FallbackToLogsOnError.
+	// +kubebuilder:validation:Enum=File;FallbackToLogsOnError
+	// +optional
+	TerminationMessagePolicy *corev1.TerminationMessagePolicy `json:"terminationMessagePolicy,omitempty"`
+
+	// UnmanagedFields lists MLflow Deployment fields that the operator leaves
+	// to other controllers. Listed paths are dropped from the applied object, so
+	// Server-Side Apply releases them and reconciles no longer revert them. For
+	// example, list spec.replicas when a HorizontalPodAutoscaler scales MLflow.
+	// When spec.replicas is listed, the replicas field of this resource is
+	// ignored, except that operator-managed migrations still scale the
+	// Deployment to zero while they run.
+	// +kubebuilder:validation:MaxItems=4
+	// +kubebuilder:validation:items:Enum=spec.replicas;spec.template.spec.affinity;spec.template.spec.nodeSelector;spec.template.spec.tolerations
+	// +listType=set
+	// +optional
+	UnmanagedFields []string `json:"unmanagedFields,omitempty"`
+
+	// Probes configures the MLflow container health probes.
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+
+	// DefaultExperiment is the name of an experiment to create when the MLflow
+	// pod starts, if it does not already exist. An init container creates it
+	// directly in the SQL backend store, in the default workspace, using the
+	// server's default artifact root. Existing experiments are left unchanged.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=500
+	// +optional
+	DefaultExperiment *string `json:"defaultExperiment,omitempty"`
+
+	// Service configures the Services that expose the MLflow server.
+	// +optional
+	Service *ServiceSpec `json:"service,omitempty"`
+
+	// Monitoring configures the ServiceMonitor that scrapes MLflow metrics.
+	// It only takes effect when the ServiceMonitor CRD is installed.
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+
+	// OpenShift configures OpenShift-specific resources. It is ignored on
+	// clusters without the corresponding APIs.
+	// +optional
+	OpenShift *OpenShiftSpec `json:"openShift,omitempty"`
+
+	// Ingress configures a networking.k8s.io/v1 Ingress that exposes the
+	// MLflow Service, for clusters without OpenShift Routes.
+	// +optional
+	Ingress *IngressConfig `json:"ingress,omitempty"`
+
+	// TLS configures how the MLflow server's serving certificate is provisioned.
+	// By default the certificate comes from the OpenShift service-ca operator.
+	// +optional
+	TLS *TLSSpec `json:"tls,omitempty"`
+
 	// ExtraAllowedOrigins is a list of additional origins to allow for CORS requests.
 	// The operator preconfigures safe defaults including Kubernetes service names,
 	// the data science gateway domain, and localhost.
@@ -203,6 +459,32 @@ type MLflowSpec struct {
 	// +optional
 	ExtraAllowedOrigins []string `json:"extraAllowedOrigins,omitempty"`
 
+	// AllowedHosts replaces the Host header values MLflow accepts. When unset,
+	// the operator allows the Service DNS names, the gateway hostname, and the
+	// hosts of the Route, Ingress and LoadBalancer Service, including those
+	// assigned by the cluster. Entries may use "*" wildcards, such as
+	// "mlflow.example.com:*". Loopback addresses and the pod IP are always
+	// allowed so health probes and metrics scrapes keep working. To add hosts
+	// while keeping the defaults, use ExtraAllowedHosts.
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern=`^[^,\s]+$`
+	// +listType=atomic
+	// +optional
+	AllowedHosts []string `json:"allowedHosts,omitempty"`
+
+	// ExtraAllowedHosts are added to the allowed Host header values, whether
+	// they come from the defaults or from AllowedHosts. Use it for names the
+	// operator cannot discover, such as node addresses of a NodePort Service.
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern=`^[^,\s]+$`
+	// +listType=atomic
+	// +optional
+	ExtraAllowedHosts []string `json:"extraAllowedHosts,omitempty"`
+
 	// WorkspaceLabelSelector is a label selector used to determine which namespaces are exposed
 	// as MLflow workspaces when using the Kubernetes workspace provider.
 	// +optional
@@ -219,8 +501,10 @@ type MLflowSpec struct {
 	// PodLabels are labels to add only to the MLflow pod, not to other resources.
 	// Use this for pod-specific labels like version, component-specific metadata, etc.
 	// For labels that should be applied to all resources (Service, Deployment, etc.), use commonLabels in values.yaml.
+	// The operator-managed keys app, app.kubernetes.io/instance, and component are reserved.
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="self.all(key, size(self[key]) <= 63)",message="label values must be 63 characters or less"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(key, key in ['app', 'app.kubernetes.io/instance', 'component'])",message="podLabels must not set the reserved keys app, app.kubernetes.io/instance, or component"
 	PodLabels map[string]string `json:"podLabels,omitempty"`
 
 	// PodAnnotations are annotations to add only to the MLflow pod, not to other resources.
@@ -249,6 +533,52 @@ type MLflowSpec struct {
 	// +optional
 	Affinity *corev1.Affinity `json:"affinity,omitempty"`
 
+	// SchedulingGates keep MLflow pods, including migration Job pods, from
+	// being scheduled until every gate is removed from the pod. Use them
+	// when an external controller must first finish provisioning a
+	// dependency such as the database. The operator does not remove gates.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SchedulingGates []corev1.PodSchedulingGate `json:"schedulingGates,omitempty"`
+
+	// PriorityClassName assigns MLflow pods, including migration Job pods, to
+	// a PriorityClass so they are scheduled and evicted according to its
+	// priority. When unset, the cluster's default priority applies.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+
+	// Hostname sets the hostname of the MLflow pods. Together with Subdomain
+	// and the headless Service it gives a pod the stable DNS name
+	// <hostname>.<subdomain>.<namespace>.svc. Every replica shares the
+	// hostname, so the name only identifies a single pod with one replica.
+	// When unset, the pod name is used.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Subdomain sets the subdomain of the MLflow pods. Kubernetes only
+	// publishes pod DNS records when it names a headless Service selecting
+	// the pods, so set it to mlflow-headless (mlflow-headless-<name> for
+	// non-singleton instances) and enable service.headless.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Subdomain *string `json:"subdomain,omitempty"`
+
+	// HostAliases adds entries to /etc/hosts of the MLflow pods, for hosts
+	// such as the database that cluster DNS cannot resolve, for example in
+	// air-gapped environments. The entries apply to every container of the
+	// pods, including init containers, and to the migration, garbage
+	// collection and trace archival Jobs, which reach the same stores.
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	HostAliases []corev1.HostAlias `json:"hostAliases,omitempty"`
+
 	// ResourceClaims defines which ResourceClaims must be allocated
 	// and reserved before the Pod is allowed to start. The resources
 	// will be made available to those containers which consume them
@@ -265,6 +595,44 @@ type MLflowSpec struct {
 	// +optional
 	ResourceClaims []corev1.PodResourceClaim `json:"resourceClaims,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
 
+	// ExtraVolumes are added to the MLflow pod next to the operator-managed
+	// volumes, for example a ConfigMap with a custom logging configuration.
+	// Names must not collide with the operator-managed volumes.
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:XValidation:rule="self.all(v, !(v.name in ['tmp', 'mlflow-storage', 'mlflow-tls', 'combined-ca-bundle', 'metrics', 'trace-archival-config', 'aws-config', 'serviceaccount-token']) && !v.name.startsWith('ca-bundle-'))",message="extraVolumes names must not collide with operator-managed volumes"
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ExtraVolumes []corev1.Volume `json:"extraVolumes,omitempty"`
+
+	// ExtraVolumeMounts are added to the MLflow container next to the
+	// operator-managed mounts. They usually reference ExtraVolumes. Mounts
+	// of Secret, ConfigMap, projected and downwardAPI volumes are always
+	// read-only. The migration Job only keeps the mounts listed in
+	// migration.extraVolumeMounts.
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=atomic
+	// +optional
+	ExtraVolumeMounts []corev1.VolumeMount `json:"extraVolumeMounts,omitempty"`
+
+	// ExtraContainers are sidecar containers added to the MLflow pod after the
+	// operator-managed containers, for example a logging or metrics exporter.
+	// They are not added to the migration Job.
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:XValidation:rule="self.all(c, !(c.name in ['mlflow', 'ca-bundle-watcher']))",message="extraContainers names must not collide with operator-managed containers"
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ExtraContainers []corev1.Container `json:"extraContainers,omitempty"`
+
+	// ServiceAccountToken limits which containers receive the service account
+	// token. When set, the token is not automounted into the pod and is
+	// projected only into the MLflow server container, which needs it for
+	// Kubernetes authentication, and the sidecars listed in Containers.
+	// Init containers and other sidecars get no token.
+	// +optional
+	ServiceAccountToken *ServiceAccountTokenConfig `json:"serviceAccountToken,omitempty"`
+
 	// CABundleConfigMap specifies a ConfigMap containing a CA certificate bundle.
 	// The bundle will be mounted into the MLflow container and configured for use
 	// with TLS connections (e.g. PostgreSQL SSL, S3 with custom certificates).
@@ -290,6 +658,10 @@ type MLflowSpec struct {
 	// +kubebuilder:validation:MaxItems=32
 	NetworkPolicyAdditionalEgressRules []networkingv1.NetworkPolicyEgressRule `json:"networkPolicyAdditionalEgressRules,omitempty"`
 
+	// NetworkPolicy configures the NetworkPolicy rendered for the MLflow pods.
+	// +optional
+	NetworkPolicy *NetworkPolicySpec `json:"networkPolicy,omitempty"`
+
 	// GarbageCollection configures a CronJob that permanently deletes soft-deleted
 	// MLflow resources (runs, experiments, and logged models) along with their artifacts.
 	// Resources must be soft-deleted first (e.g. via the UI or API) before garbage
@@ -317,6 +689,612 @@ type CABundleConfigMapSpec struct {
 	Name string `json:"name"`
 }
 
+// ArtifactStoreSpec configures how MLflow connects to the artifact store.
+type ArtifactStoreSpec struct {
+	// S3 configures the boto3 client used for s3:// artifact locations.
+	// +optional
+	S3 *S3ArtifactStoreSpec `json:"s3,omitempty"`
+
+	// CABundleSecret names a Secret with the CA certificates of the artifact
+	// store endpoint, for stores signed by an internal CA. All .crt and .pem
+	// keys are added to the combined CA bundle that AWS_CA_BUNDLE and
+	// REQUESTS_CA_BUNDLE point to, next to CABundleConfigMap and the
+	// platform bundle, so the other TLS clients trust them too.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	CABundleSecret *string `json:"caBundleSecret,omitempty"`
+}
+
+// S3ArtifactStoreSpec configures S3 and S3-compatible artifact stores.
+// +kubebuilder:validation:XValidation:rule="!has(self.sourceRoleARN) || has(self.assumeRoleARN)",message="sourceRoleARN requires assumeRoleARN"
+// +kubebuilder:validation:XValidation:rule="!has(self.externalID) || has(self.sourceRoleARN)",message="externalID requires sourceRoleARN"
+// +kubebuilder:validation:XValidation:rule="has(self.accessKeyIDSecret) == has(self.secretAccessKeySecret)",message="accessKeyIDSecret and secretAccessKeySecret must be set together"
+// +kubebuilder:validation:XValidation:rule="!has(self.sessionTokenSecret) || has(self.accessKeyIDSecret)",message="sessionTokenSecret requires accessKeyIDSecret and secretAccessKeySecret"
+// +kubebuilder:validation:XValidation:rule="!has(self.accessKeyIDSecret) || !has(self.assumeRoleARN)",message="accessKeyIDSecret cannot be combined with assumeRoleARN"
+type S3ArtifactStoreSpec struct {
+	// EndpointURL points the S3 client at an S3-compatible endpoint such as
+	// MinIO or Ceph RGW instead of AWS, by setting MLFLOW_S3_ENDPOINT_URL.
+	// An MLFLOW_S3_ENDPOINT_URL entry in spec.env takes precedence.
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	// +kubebuilder:validation:MaxLength=2048
+	// +optional
+	EndpointURL *string `json:"endpointURL,omitempty"`
+
+	// ForcePathStyle makes the S3 client use path-style addressing
+	// (endpoint/bucket) instead of virtual-hosted addressing (bucket.endpoint).
+	// MinIO and many on-premises S3 gateways require it. When unset, the boto3
+	// default addressing style is used.
+	// +optional
+	ForcePathStyle *bool `json:"forcePathStyle,omitempty"`
+
+	// InsecureSkipVerify disables TLS certificate verification for the S3
+	// endpoint by setting MLFLOW_S3_IGNORE_TLS. INSECURE: artifact traffic can
+	// then be intercepted. Use it only in development against endpoints with
+	// self-signed certificates; prefer caBundleConfigMap everywhere else.
+	// While it is enabled the MLflow resource carries an
+	// ArtifactStoreInsecure=True status condition. Defaults to false.
+	// +optional
+	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+
+	// AssumeRoleARN is the IAM role the S3 client assumes before accessing
+	// the bucket, typically a role in the account that owns the bucket.
+	// The pod's IRSA web identity token is exchanged for this role directly,
+	// unless SourceRoleARN is set.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	// +optional
+	AssumeRoleARN *string `json:"assumeRoleARN,omitempty"`
+
+	// SourceRoleARN is the IRSA role the pod's web identity token is exchanged
+	// for first. Its credentials are then used to assume AssumeRoleARN.
+	// Set it to the eks.amazonaws.com/role-arn annotation of the pod's
+	// ServiceAccount when the bucket owner's role requires an ExternalID or
+	// does not trust the cluster's OIDC provider.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	// +optional
+	SourceRoleARN *string `json:"sourceRoleARN,omitempty"`
+
+	// ExternalID is passed to sts:AssumeRole when assuming AssumeRoleARN.
+	// Requires SourceRoleARN, because web identity role assumption does not
+	// accept an external ID.
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=1224
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9+=,.@:/-]+$`
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+
+	// AccessKeyIDSecret selects the Secret key holding the access key ID,
+	// exposed to the server as AWS_ACCESS_KEY_ID. Must be set together with
+	// SecretAccessKeySecret. Cannot be combined with AssumeRoleARN, because
+	// static credentials take precedence over the assume-role profile.
+	// +optional
+	AccessKeyIDSecret *corev1.SecretKeySelector `json:"accessKeyIDSecret,omitempty"`
+
+	// SecretAccessKeySecret selects the Secret key holding the secret access
+	// key, exposed to the server as AWS_SECRET_ACCESS_KEY.
+	// +optional
+	SecretAccessKeySecret *corev1.SecretKeySelector `json:"secretAccessKeySecret,omitempty"`
+
+	// SessionTokenSecret selects the Secret key holding the session token of
+	// temporary STS credentials, exposed to the server as AWS_SESSION_TOKEN.
+	// Requires AccessKeyIDSecret and SecretAccessKeySecret. Environment
+	// variables are read when the pod starts, so restart the pods after
+	// rotating expired credentials in the Secret.
+	// +optional
+	SessionTokenSecret *corev1.SecretKeySelector `json:"sessionTokenSecret,omitempty"`
+}
+
+// NetworkPolicySpec configures the NetworkPolicy of the MLflow pods.
+type NetworkPolicySpec struct {
+	// RestrictEgress replaces the default egress rules, which open common
+	// database and object storage ports to any destination, with rules
+	// derived from the configured store URIs: DNS, the Kubernetes API server
+	// ports 443 and 6443 used for request authorization, and the host and
+	// port of each literal backend, read-replica, registry and artifact store
+	// URI. A host given as an IP address is restricted to that address;
+	// NetworkPolicies cannot match DNS names, so other hosts only restrict the
+	// port. URIs read from Secrets cannot be inspected and are skipped; add
+	// rules for them with NetworkPolicyAdditionalEgressRules. The migration
+	// Job NetworkPolicy is restricted to the derived database endpoints.
+	// Cannot be combined with NetworkPolicyEgressRules.
+	// +optional
+	RestrictEgress *bool `json:"restrictEgress,omitempty"`
+
+	// IngressFrom lists the sources allowed to reach the MLflow server port.
+	// When empty (default), any pod in any namespace may connect, which
+	// keeps the ODH/RHOAI gateway, dashboard and Prometheus working
+	// regardless of where they run. When set, it replaces that rule, so it
+	// must include every client, the ingress controller or OpenShift router
+	// namespace serving the Route, and the Prometheus namespace when
+	// spec.monitoring is enabled. Requires a ClusterIP Service.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	IngressFrom []networkingv1.NetworkPolicyPeer `json:"ingressFrom,omitempty"`
+}
+
+// ClientTokenSpec configures the operator-managed client token Secret.
+type ClientTokenSpec struct {
+	// RotationPeriod is how long a client token Secret lives before the
+	// operator deletes and re-issues it, invalidating the previous token.
+	// Defaults to 720h (30 days). Must be at least 1h.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1h')",message="rotationPeriod must be at least 1h"
+	// +optional
+	RotationPeriod *metav1.Duration `json:"rotationPeriod,omitempty"`
+}
+
+// DatabaseSpec configures the backend store database.
+type DatabaseSpec struct {
+	// Connection describes the database as individual fields. The operator
+	// assembles the backend store URI from them. Only PostgreSQL is
+	// supported: the password is passed through the PGPASSWORD environment
+	// variable instead of being URL-encoded into the URI, which keeps it out
+	// of the URI, and only libpq reads that variable.
+	// +optional
+	Connection *DatabaseConnectionSpec `json:"connection,omitempty"`
+
+	// ReadinessCheck makes the readiness probe also open a TCP connection to
+	// the backend database, so MLflow is not marked Ready while the database
+	// is unreachable. The host and port are parsed from backendStoreUri or
+	// connection; the check is skipped when the URI is read from a Secret or
+	// spec.probes.readiness sets its own handler.
+	// Defaults to false.
+	// +optional
+	ReadinessCheck *bool `json:"readinessCheck,omitempty"`
+
+	// PoolSize is the number of connections each MLflow worker keeps open in
+	// its SQLAlchemy pool, set through MLFLOW_SQLALCHEMYSTORE_POOL_SIZE.
+	// Requires a database server backend store. When unset, SQLAlchemy keeps 5.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	PoolSize *int32 `json:"poolSize,omitempty"`
+
+	// MaxOverflow is how many connections each worker may open beyond
+	// PoolSize under load, set through MLFLOW_SQLALCHEMYSTORE_MAX_OVERFLOW.
+	// Requires a database server backend store. When unset, SQLAlchemy allows 10.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	MaxOverflow *int32 `json:"maxOverflow,omitempty"`
+
+	// PoolRecycleSeconds replaces pooled connections older than this many
+	// seconds, set through MLFLOW_SQLALCHEMYSTORE_POOL_RECYCLE. Keep it below
+	// the idle timeout of the database or of a proxy in front of it. Requires
+	// a database server backend store. When unset, connections are not recycled.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	PoolRecycleSeconds *int32 `json:"poolRecycleSeconds,omitempty"`
+}
+
+// DatabaseConnectionSpec describes a PostgreSQL backend store connection.
+type DatabaseConnectionSpec struct {
+	// Driver is the SQLAlchemy dialect and driver used as the URI scheme.
+	// +kubebuilder:validation:Enum=postgresql;postgresql+psycopg2;postgresql+psycopg
+	// +kubebuilder:default=postgresql
+	// +optional
+	Driver string `json:"driver,omitempty"`
+
+	// Host is the database server hostname or IP address.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.:-]+$`
+	Host string `json:"host"`
+
+	// Port is the database server port. Defaults to 5432.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// Database is the name of the database holding the MLflow schema.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[^/?#]+$`
+	Database string `json:"database"`
+
+	// User is the database user. It is URL-encoded into the URI.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	User string `json:"user"`
+
+	// PasswordSecret references the Secret key holding the user's password.
+	// The password is passed to libpq through PGPASSWORD and never becomes
+	// part of the URI, so it needs no URL encoding. Omit it for
+	// passwordless authentication such as client certificates.
+	// +optional
+	PasswordSecret *corev1.SecretKeySelector `json:"passwordSecret,omitempty"`
+}
+
+// ServiceSpec configures the Services that expose the MLflow server.
+// +kubebuilder:validation:XValidation:rule="!has(self.nodePort) || (has(self.type) && self.type == 'NodePort')",message="nodePort requires type NodePort"
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancerIP) || (has(self.type) && self.type == 'LoadBalancer')",message="loadBalancerIP requires type LoadBalancer"
+type ServiceSpec struct {
+	// Type is the type of the main MLflow Service. NodePort and LoadBalancer
+	// expose MLflow outside the cluster without an ingress controller or
+	// gateway. The headless Service is always clusterIP None.
+	// Defaults to ClusterIP.
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +optional
+	Type *corev1.ServiceType `json:"type,omitempty"`
+
+	// NodePort pins the port opened on every node when Type is NodePort.
+	// It must fall in the cluster's node port range, 30000-32767 by default.
+	// When unset, Kubernetes allocates one.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	NodePort *int32 `json:"nodePort,omitempty"`
+
+	// LoadBalancerIP requests a specific address from the load balancer
+	// provider when Type is LoadBalancer. Providers that do not support it
+	// ignore it. The address is also added to the default allowed hosts.
+	// +kubebuilder:validation:MaxLength=45
+	// +kubebuilder:validation:Pattern=`^[0-9A-Fa-f.:]+$`
+	// +optional
+	LoadBalancerIP *string `json:"loadBalancerIP,omitempty"`
+
+	// Headless renders an additional mlflow-headless Service with
+	// clusterIP None next to the main Service. Its DNS name resolves to the
+	// individual pod IPs, so clients can address a specific replica.
+	// Defaults to false.
+	// +optional
+	Headless *bool `json:"headless,omitempty"`
+
+	// PortName is the name of the HTTPS port on the MLflow Services. The
+	// OpenShift Route and the ServiceMonitor reference the port by this name,
+	// so set it to match Routes or Gateways managed outside the operator that
+	// expect a particular name. Must be an IANA service name.
+	// Defaults to https.
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self.matches('[a-z]') && !self.contains('--')",message="portName must contain a letter and must not contain consecutive hyphens"
+	// +optional
+	PortName *string `json:"portName,omitempty"`
+}
+
+// ServerTimeoutsConfig tunes the uvicorn connection timeouts of the MLflow server.
+type ServerTimeoutsConfig struct {
+	// KeepAliveSeconds is how long an idle keep-alive connection stays open.
+	// Set it above the idle timeout of the gateway or load balancer in front
+	// of MLflow, so the server does not close a connection the proxy is about
+	// to reuse, which surfaces as 502 responses during long artifact uploads.
+	// It maps to uvicorn's --timeout-keep-alive. When unset, uvicorn's default
+	// of 5 seconds applies.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	KeepAliveSeconds *int32 `json:"keepAliveSeconds,omitempty"`
+
+	// GracefulShutdownSeconds is how long a worker waits for in-flight
+	// requests after SIGTERM before closing them. It maps to uvicorn's
+	// --timeout-graceful-shutdown. Together with shutdownDelaySeconds it must
+	// stay below terminationGracePeriodSeconds (default 30). When unset,
+	// uvicorn waits for in-flight requests until the pod is killed.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3599
+	// +optional
+	GracefulShutdownSeconds *int32 `json:"gracefulShutdownSeconds,omitempty"`
+}
+
+// EffectiveConfigSpec configures the effective server configuration ConfigMap.
+type EffectiveConfigSpec struct {
+	// Enabled creates the mlflow-effective-config ConfigMap. Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// AuthConfig configures the MLflow kubernetes-auth app.
+type AuthConfig struct {
+	// AuthorizationMode selects how MLflow checks a caller's access. It sets
+	// MLFLOW_K8S_AUTH_AUTHORIZATION_MODE on the server.
+	// SelfSubjectAccessReview creates a SelfSubjectAccessReview with the
+	// caller's token. SubjectAccessReview has the server create a
+	// SubjectAccessReview for the caller with its own ServiceAccount, which is
+	// then granted create on subjectaccessreviews.
+	// Defaults to SelfSubjectAccessReview.
+	// +kubebuilder:validation:Enum=SelfSubjectAccessReview;SubjectAccessReview
+	// +optional
+	AuthorizationMode MLflowAuthorizationMode `json:"authorizationMode,omitempty"`
+}
+
+// MLflowAuthorizationMode selects how the kubernetes-auth app authorizes requests.
+type MLflowAuthorizationMode string
+
+const (
+	// MLflowAuthorizationSelfSubjectAccessReview authorizes with the caller's own token.
+	MLflowAuthorizationSelfSubjectAccessReview MLflowAuthorizationMode = "SelfSubjectAccessReview"
+	// MLflowAuthorizationSubjectAccessReview authorizes with the server's ServiceAccount.
+	MLflowAuthorizationSubjectAccessReview MLflowAuthorizationMode = "SubjectAccessReview"
+)
+
+// LoggingConfig configures the MLflow server logs.
+type LoggingConfig struct {
+	// AccessLog controls uvicorn's access log, which writes one line per
+	// request to stdout. True passes --access-log and false passes
+	// --no-access-log. When unset, uvicorn's default applies.
+	// +optional
+	AccessLog *bool `json:"accessLog,omitempty"`
+}
+
+// AutoscalingConfig configures a HorizontalPodAutoscaler for the MLflow server.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must not exceed maxReplicas"
+type AutoscalingConfig struct {
+	// Enabled creates an autoscaling/v2 HorizontalPodAutoscaler that scales
+	// the MLflow Deployment on CPU utilization.
+	// Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinReplicas is the lower replica bound. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper replica bound.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the average CPU utilization, relative
+	// to the container's CPU request, that the autoscaler aims for.
+	// Requires resources.requests.cpu to be set. Defaults to 80.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+}
+
+// PDBConfig configures a PodDisruptionBudget for the MLflow server.
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="minAvailable and maxUnavailable are mutually exclusive"
+type PDBConfig struct {
+	// Enabled creates a policy/v1 PodDisruptionBudget that selects the MLflow
+	// pods. When unset, a budget with maxUnavailable 1 is created
+	// automatically while the Deployment runs more than one replica
+	// (replicas, or autoscaling.minReplicas). Set it to false to opt out.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinAvailable is the number or percentage of MLflow pods that must stay
+	// available during a voluntary disruption. Defaults to 1 when Enabled is
+	// true and neither MinAvailable nor MaxUnavailable is set. With a single
+	// replica this blocks node drains until another replica is running.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or percentage of MLflow pods that may be
+	// unavailable during a voluntary disruption. Mutually exclusive with
+	// MinAvailable.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// OpenShiftSpec configures OpenShift-specific resources.
+type OpenShiftSpec struct {
+	// Route configures an OpenShift Route that exposes the MLflow Service.
+	// +optional
+	Route *RouteSpec `json:"route,omitempty"`
+}
+
+// RouteSpec configures the OpenShift Route for the MLflow server.
+type RouteSpec struct {
+	// Enabled creates a route.openshift.io/v1 Route to the MLflow Service.
+	// Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Host is the externally reachable hostname. When unset, the router
+	// generates one from the cluster's ingress domain. Setting it requires
+	// the routes/custom-host permission, which the operator holds.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// TLSTermination selects how the router handles TLS. The MLflow server
+	// only serves HTTPS with its service-ca certificate, so edge termination
+	// is not offered. "reencrypt" (the default) presents the router's
+	// certificate to clients. "passthrough" presents the service-ca
+	// certificate directly. With tls.certManager the router does not trust
+	// the backend certificate, so only "passthrough" is allowed, and it is
+	// the default.
+	// +kubebuilder:validation:Enum=reencrypt;passthrough
+	// +optional
+	TLSTermination *string `json:"tlsTermination,omitempty"`
+}
+
+// ServiceAccountTokenConfig configures the projected service account token.
+type ServiceAccountTokenConfig struct {
+	// Containers names the spec.extraContainers that also receive the token,
+	// for example an authenticating proxy that issues SubjectAccessReviews.
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=63
+	// +listType=set
+	// +optional
+	Containers []string `json:"containers,omitempty"`
+}
+
+// IngressConfig configures the Ingress for the MLflow server.
+type IngressConfig struct {
+	// Enabled creates an Ingress routing all paths on Host to the MLflow
+	// Service. Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ClassName is the IngressClass that implements the Ingress. When unset,
+	// the cluster's default IngressClass is used.
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	ClassName *string `json:"className,omitempty"`
+
+	// Host is the externally reachable hostname. When unset, the rule
+	// matches requests for any host.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// Annotations are added to the Ingress. The MLflow server only serves
+	// HTTPS, so the operator sets nginx.ingress.kubernetes.io/backend-protocol
+	// to HTTPS unless it is overridden here.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Streaming turns off response and request buffering in ingress-nginx,
+	// so large artifact downloads and uploads are streamed through the
+	// controller instead of being held in its memory or temporary files.
+	// It sets nginx.ingress.kubernetes.io/proxy-buffering and
+	// proxy-request-buffering to "off"; Annotations override either one.
+	// Other ingress controllers need their own equivalent annotations.
+	// Defaults to false.
+	// +optional
+	Streaming *bool `json:"streaming,omitempty"`
+
+	// TLS configures TLS termination at the ingress controller.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	TLS []networkingv1.IngressTLS `json:"tls,omitempty"`
+}
+
+// TLSSpec configures the MLflow server's serving certificate.
+type TLSSpec struct {
+	// CertManager provisions the serving certificate with cert-manager, for
+	// clusters without the OpenShift service-ca operator.
+	// +optional
+	CertManager *CertManagerConfig `json:"certManager,omitempty"`
+}
+
+// CertManagerConfig configures a cert-manager.io/v1 Certificate that writes
+// the MLflow TLS Secret. The issuer must include ca.crt in the Secret so that
+// in-cluster clients such as Prometheus can verify the server.
+// +kubebuilder:validation:XValidation:rule="!has(self.enabled) || !self.enabled || has(self.issuerRef)",message="issuerRef is required when certManager is enabled"
+type CertManagerConfig struct {
+	// Enabled renders the Certificate and stops requesting a service-ca
+	// certificate for the MLflow Service.
+	// Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// IssuerRef references the cert-manager Issuer or ClusterIssuer that
+	// signs the certificate.
+	// +optional
+	IssuerRef *CertManagerIssuerReference `json:"issuerRef,omitempty"`
+
+	// Duration is the requested certificate lifetime. cert-manager defaults
+	// to 90 days when unset.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// CertManagerIssuerReference references a cert-manager issuer.
+type CertManagerIssuerReference struct {
+	// Name is the name of the issuer.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Kind is the issuer kind. Defaults to Issuer, which must live in the
+	// MLflow namespace.
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group is the issuer API group. Defaults to cert-manager.io.
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
+// MonitoringSpec configures how Prometheus scrapes the MLflow server.
+type MonitoringSpec struct {
+	// MetricRelabelings are applied to scraped samples before ingestion.
+	// Use them to drop high-cardinality labels or whole series.
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	MetricRelabelings []RelabelConfig `json:"metricRelabelings,omitempty"`
+
+	// Relabelings are applied to the scrape target's labels before scraping.
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	Relabelings []RelabelConfig `json:"relabelings,omitempty"`
+}
+
+// RelabelConfig is a Prometheus relabeling rule. It mirrors the
+// prometheus-operator RelabelConfig and is copied into the ServiceMonitor
+// endpoint unchanged.
+type RelabelConfig struct {
+	// SourceLabels selects values from existing labels. Their content is
+	// concatenated using Separator and matched against Regex.
+	// +optional
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+
+	// Separator placed between concatenated source label values.
+	// Prometheus defaults to ";".
+	// +optional
+	Separator *string `json:"separator,omitempty"`
+
+	// TargetLabel is the label the result is written to for replace,
+	// hashmod, lowercase and uppercase actions.
+	// +optional
+	TargetLabel string `json:"targetLabel,omitempty"`
+
+	// Regex matched against the concatenated source label values.
+	// Prometheus defaults to "(.*)".
+	// +optional
+	Regex string `json:"regex,omitempty"`
+
+	// Modulus taken of the hash of the source label values. Only used by
+	// the hashmod action.
+	// +optional
+	Modulus uint64 `json:"modulus,omitempty"`
+
+	// Replacement value written to TargetLabel when Regex matches.
+	// Prometheus defaults to "$1".
+	// +optional
+	Replacement *string `json:"replacement,omitempty"`
+
+	// Action to perform based on the regex match. Prometheus defaults to replace.
+	// +kubebuilder:validation:Enum=replace;Replace;keep;Keep;drop;Drop;hashmod;HashMod;labelmap;LabelMap;labeldrop;LabelDrop;labelkeep;LabelKeep;lowercase;Lowercase;uppercase;Uppercase;keepequal;KeepEqual;dropequal;DropEqual
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+// ProbesSpec configures the MLflow container health probes.
+type ProbesSpec struct {
+	// Path overrides the liveness probe path. It is appended to the static
+	// prefix, so "/health" resolves to "/mlflow/health" for operator-managed
+	// deployments. The readiness probe keeps using the server-info endpoint.
+	// Defaults to "/health".
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9._~/-]*$`
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Liveness overrides the MLflow container liveness probe. Fields left
+	// unset keep the chart defaults, and a probe without a handler uses an
+	// HTTPS GET on Path. Defaults: initialDelaySeconds 30, timeoutSeconds 1,
+	// periodSeconds 10, successThreshold 1, failureThreshold 3.
+	// +kubebuilder:validation:XValidation:rule="!has(self.successThreshold) || self.successThreshold == 1",message="liveness successThreshold must be 1"
+	// +optional
+	Liveness *corev1.Probe `json:"liveness,omitempty"`
+
+	// Readiness overrides the MLflow container readiness probe. Fields left
+	// unset keep the chart defaults, and a probe without a handler uses an
+	// HTTPS GET on the server-info endpoint. Defaults: initialDelaySeconds 5,
+	// or 15 when the backend store is a database server, timeoutSeconds 1,
+	// periodSeconds 5, successThreshold 1, failureThreshold 3.
+	// +optional
+	Readiness *corev1.Probe `json:"readiness,omitempty"`
+
+	// Startup adds a startup probe to the MLflow container, which holds off
+	// the liveness and readiness probes until it succeeds. Useful when
+	// database connection or schema checks make startup slow. A probe
+	// without a handler uses an HTTPS GET on Path. Not set by default, and
+	// unset fields take the Kubernetes defaults.
+	// +kubebuilder:validation:XValidation:rule="!has(self.successThreshold) || self.successThreshold == 1",message="startup successThreshold must be 1"
+	// +optional
+	Startup *corev1.Probe `json:"startup,omitempty"`
+}
+
 // GarbageCollectionSpec configures periodic garbage collection via `mlflow gc`.
 // The CronJob permanently removes soft-deleted runs, experiments, and logged models
 // along with their associated artifacts from the configured backend and artifact stores.
@@ -394,11 +1372,26 @@ type TraceArchivalSpec struct {
 }
 
 // ImageConfig contains container image configuration
+// +kubebuilder:validation:XValidation:rule="!(has(self.image) && (has(self.repository) || has(self.tag)))",message="image cannot be combined with repository or tag"
 type ImageConfig struct {
 	// Image is the container image (includes tag)
 	// +optional
 	Image *string `json:"image,omitempty"`
 
+	// Repository is the image repository without a tag, for example
+	// quay.io/opendatahub/mlflow. When only Tag is set, the repository of the
+	// operator's default image is used. Mutually exclusive with Image.
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^([^@\s]*/)?[^@\s:/]+$`
+	// +optional
+	Repository *string `json:"repository,omitempty"`
+
+	// Tag is the image tag. When only Repository is set, the tag or digest of
+	// the operator's default image is kept. Mutually exclusive with Image.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`
+	// +optional
+	Tag *string `json:"tag,omitempty"`
+
 	// ImagePullPolicy is the image pull policy.
 	// If not specified, uses Kubernetes defaults (IfNotPresent for most images, Always for :latest tag).
 	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
@@ -408,6 +1401,16 @@ type ImageConfig struct {
 
 // MLflowMigrationConfig controls operator-managed database migration behavior.
 type MLflowMigrationConfig struct {
+	// Enabled turns the operator-managed migration flow on or off. Set it to
+	// false when the database schema is managed outside the operator, for
+	// example an externally migrated or read-replica database. The operator
+	// then never scales MLflow down or creates a migration Job, and the
+	// MLflow Deployment starts directly. Mode, force-migrate, and the other
+	// migration settings are ignored while it is false.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// Mode controls how the operator runs database migration orchestration.
 	// Automatic runs the operator-managed migration flow when bootstrap or
 	// version detection indicates it is needed. Always forces the
@@ -429,6 +1432,47 @@ type MLflowMigrationConfig struct {
 	// +kubebuilder:validation:Minimum=3600
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// TimeoutSeconds bounds how long each migration attempt may run. The
+	// migration command is wrapped with timeout(1), so an attempt that hangs,
+	// for example on database lock contention, exits with code 124 and the
+	// Migration condition reports the timeout. Timed-out attempts are retried
+	// like other retryable failures. When omitted, attempts run until they
+	// finish.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// Labels are added to the migration Job and its pod, for example to tag
+	// migration workloads for cost allocation. They are applied on top of the
+	// MLflow pod labels; operator-managed migration labels cannot be overridden,
+	// and the reserved pod label keys app, app.kubernetes.io/instance and
+	// component are ignored.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(key, size(self[key]) <= 63)",message="label values must be 63 characters or less"
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to the migration Job and its pod.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Resources overrides the compute resources of the migration Job container.
+	// When omitted, the migration container inherits the MLflow server
+	// container resources. Large-schema migrations may need more memory than
+	// the server itself. Each request must not exceed its matching limit.
+	// Resource claims are ignored because the migration pod does not allocate
+	// the server's DRA claims.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// ExtraVolumeMounts names the spec.extraVolumeMounts entries that are also
+	// mounted in the migration Job container, for example a logging config the
+	// migration should use. Other extra mounts, and volumes only they use,
+	// are left out of the migration pod.
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	// +optional
+	ExtraVolumeMounts []string `json:"extraVolumeMounts,omitempty"`
 }
 
 // MLflowMigrateMode controls operator-managed database migration behavior.
@@ -479,6 +1523,18 @@ type MLflowStatus struct {
 	// +optional
 	// +kubebuilder:validation:MaxLength=64
 	Version string `json:"version,omitempty"`
+
+	// chartVersion records the version of the Helm chart that rendered the
+	// deployment.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	ChartVersion string `json:"chartVersion,omitempty"`
+
+	// operatorVersion records the version of the operator that last
+	// reconciled the deployment.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	OperatorVersion string `json:"operatorVersion,omitempty"`
 }
 
 // +kubebuilder:object:root=true
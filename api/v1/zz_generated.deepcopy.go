@@ -25,8 +25,79 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactStoreSpec) DeepCopyInto(out *ArtifactStoreSpec) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3ArtifactStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CABundleSecret != nil {
+		in, out := &in.CABundleSecret, &out.CABundleSecret
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactStoreSpec.
+func (in *ArtifactStoreSpec) DeepCopy() *ArtifactStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthConfig) DeepCopyInto(out *AuthConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthConfig.
+func (in *AuthConfig) DeepCopy() *AuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingConfig) DeepCopyInto(out *AutoscalingConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingConfig.
+func (in *AutoscalingConfig) DeepCopy() *AutoscalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CABundleConfigMapSpec) DeepCopyInto(out *CABundleConfigMapSpec) {
 	*out = *in
@@ -42,6 +113,156 @@ func (in *CABundleConfigMapSpec) DeepCopy() *CABundleConfigMapSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerConfig) DeepCopyInto(out *CertManagerConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertManagerIssuerReference)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerConfig.
+func (in *CertManagerConfig) DeepCopy() *CertManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerReference) DeepCopyInto(out *CertManagerIssuerReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference.
+func (in *CertManagerIssuerReference) DeepCopy() *CertManagerIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientTokenSpec) DeepCopyInto(out *ClientTokenSpec) {
+	*out = *in
+	if in.RotationPeriod != nil {
+		in, out := &in.RotationPeriod, &out.RotationPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientTokenSpec.
+func (in *ClientTokenSpec) DeepCopy() *ClientTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseConnectionSpec) DeepCopyInto(out *DatabaseConnectionSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseConnectionSpec.
+func (in *DatabaseConnectionSpec) DeepCopy() *DatabaseConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
+	*out = *in
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(DatabaseConnectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessCheck != nil {
+		in, out := &in.ReadinessCheck, &out.ReadinessCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PoolSize != nil {
+		in, out := &in.PoolSize, &out.PoolSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxOverflow != nil {
+		in, out := &in.MaxOverflow, &out.MaxOverflow
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PoolRecycleSeconds != nil {
+		in, out := &in.PoolRecycleSeconds, &out.PoolRecycleSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
+func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveConfigSpec) DeepCopyInto(out *EffectiveConfigSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveConfigSpec.
+func (in *EffectiveConfigSpec) DeepCopy() *EffectiveConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GarbageCollectionSpec) DeepCopyInto(out *GarbageCollectionSpec) {
 	*out = *in
@@ -75,6 +296,16 @@ func (in *ImageConfig) DeepCopyInto(out *ImageConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
 	if in.ImagePullPolicy != nil {
 		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
 		*out = new(corev1.PullPolicy)
@@ -92,6 +323,75 @@ func (in *ImageConfig) DeepCopy() *ImageConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressConfig) DeepCopyInto(out *IngressConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClassName != nil {
+		in, out := &in.ClassName, &out.ClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = make([]networkingv1.IngressTLS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressConfig.
+func (in *IngressConfig) DeepCopy() *IngressConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
+	*out = *in
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfig.
+func (in *LoggingConfig) DeepCopy() *LoggingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MLflow) DeepCopyInto(out *MLflow) {
 	*out = *in
@@ -169,11 +469,45 @@ func (in *MLflowList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MLflowMigrationConfig) DeepCopyInto(out *MLflowMigrationConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.TTLSecondsAfterFinished != nil {
 		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
 		*out = new(int32)
 		**out = **in
 	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtraVolumeMounts != nil {
+		in, out := &in.ExtraVolumeMounts, &out.ExtraVolumeMounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLflowMigrationConfig.
@@ -199,6 +533,16 @@ func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PDBConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Migration != nil {
 		in, out := &in.Migration, &out.Migration
 		*out = new(MLflowMigrationConfig)
@@ -219,6 +563,26 @@ func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
 		*out = new(corev1.PersistentVolumeClaimSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExistingStorageClaim != nil {
+		in, out := &in.ExistingStorageClaim, &out.ExistingStorageClaim
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageClaimName != nil {
+		in, out := &in.StorageClaimName, &out.StorageClaimName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ArtifactsSubPath != nil {
+		in, out := &in.ArtifactsSubPath, &out.ArtifactsSubPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ArtifactsReadOnly != nil {
+		in, out := &in.ArtifactsReadOnly, &out.ArtifactsReadOnly
+		*out = new(bool)
+		**out = **in
+	}
 	if in.BackendStoreURI != nil {
 		in, out := &in.BackendStoreURI, &out.BackendStoreURI
 		*out = new(string)
@@ -229,6 +593,11 @@ func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
 		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(DatabaseSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ReadReplicaBackendStoreURI != nil {
 		in, out := &in.ReadReplicaBackendStoreURI, &out.ReadReplicaBackendStoreURI
 		*out = new(string)
@@ -254,11 +623,21 @@ func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ArtifactsDestinationFrom != nil {
+		in, out := &in.ArtifactsDestinationFrom, &out.ArtifactsDestinationFrom
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DefaultArtifactRoot != nil {
 		in, out := &in.DefaultArtifactRoot, &out.DefaultArtifactRoot
 		*out = new(string)
 		**out = **in
 	}
+	if in.ArtifactStore != nil {
+		in, out := &in.ArtifactStore, &out.ArtifactStore
+		*out = new(ArtifactStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServeArtifacts != nil {
 		in, out := &in.ServeArtifacts, &out.ServeArtifacts
 		*out = new(bool)
@@ -269,19 +648,129 @@ func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
 		*out = new(int32)
 		**out = **in
 	}
-	if in.ExtraAllowedOrigins != nil {
-		in, out := &in.ExtraAllowedOrigins, &out.ExtraAllowedOrigins
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.MaxRequestHeaderBytes != nil {
+		in, out := &in.MaxRequestHeaderBytes, &out.MaxRequestHeaderBytes
+		*out = new(int32)
+		**out = **in
 	}
-	if in.WorkspaceLabelSelector != nil {
-		in, out := &in.WorkspaceLabelSelector, &out.WorkspaceLabelSelector
-		*out = new(metav1.LabelSelector)
+	if in.ServerTimeouts != nil {
+		in, out := &in.ServerTimeouts, &out.ServerTimeouts
+		*out = new(ServerTimeoutsConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]corev1.EnvVar, len(*in))
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EffectiveConfig != nil {
+		in, out := &in.EffectiveConfig, &out.EffectiveConfig
+		*out = new(EffectiveConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasePath != nil {
+		in, out := &in.BasePath, &out.BasePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TmpVolumeSizeLimit != nil {
+		in, out := &in.TmpVolumeSizeLimit, &out.TmpVolumeSizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AuthConfig)
+		**out = **in
+	}
+	if in.ClientToken != nil {
+		in, out := &in.ClientToken, &out.ClientToken
+		*out = new(ClientTokenSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShutdownDelaySeconds != nil {
+		in, out := &in.ShutdownDelaySeconds, &out.ShutdownDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TerminationMessagePolicy != nil {
+		in, out := &in.TerminationMessagePolicy, &out.TerminationMessagePolicy
+		*out = new(corev1.TerminationMessagePolicy)
+		**out = **in
+	}
+	if in.UnmanagedFields != nil {
+		in, out := &in.UnmanagedFields, &out.UnmanagedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultExperiment != nil {
+		in, out := &in.DefaultExperiment, &out.DefaultExperiment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ServiceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenShift != nil {
+		in, out := &in.OpenShift, &out.OpenShift
+		*out = new(OpenShiftSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtraAllowedOrigins != nil {
+		in, out := &in.ExtraAllowedOrigins, &out.ExtraAllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedHosts != nil {
+		in, out := &in.AllowedHosts, &out.AllowedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraAllowedHosts != nil {
+		in, out := &in.ExtraAllowedHosts, &out.ExtraAllowedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkspaceLabelSelector != nil {
+		in, out := &in.WorkspaceLabelSelector, &out.WorkspaceLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -336,6 +825,33 @@ func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
 		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SchedulingGates != nil {
+		in, out := &in.SchedulingGates, &out.SchedulingGates
+		*out = make([]corev1.PodSchedulingGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subdomain != nil {
+		in, out := &in.Subdomain, &out.Subdomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostAliases != nil {
+		in, out := &in.HostAliases, &out.HostAliases
+		*out = make([]corev1.HostAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ResourceClaims != nil {
 		in, out := &in.ResourceClaims, &out.ResourceClaims
 		*out = make([]corev1.PodResourceClaim, len(*in))
@@ -343,6 +859,32 @@ func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExtraVolumes != nil {
+		in, out := &in.ExtraVolumes, &out.ExtraVolumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraVolumeMounts != nil {
+		in, out := &in.ExtraVolumeMounts, &out.ExtraVolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraContainers != nil {
+		in, out := &in.ExtraContainers, &out.ExtraContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ServiceAccountTokenConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CABundleConfigMap != nil {
 		in, out := &in.CABundleConfigMap, &out.CABundleConfigMap
 		*out = new(CABundleConfigMapSpec)
@@ -362,6 +904,11 @@ func (in *MLflowSpec) DeepCopyInto(out *MLflowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GarbageCollection != nil {
 		in, out := &in.GarbageCollection, &out.GarbageCollection
 		*out = new(GarbageCollectionSpec)
@@ -411,6 +958,372 @@ func (in *MLflowStatus) DeepCopy() *MLflowStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringSpec) DeepCopyInto(out *MonitoringSpec) {
+	*out = *in
+	if in.MetricRelabelings != nil {
+		in, out := &in.MetricRelabelings, &out.MetricRelabelings
+		*out = make([]RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Relabelings != nil {
+		in, out := &in.Relabelings, &out.Relabelings
+		*out = make([]RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringSpec.
+func (in *MonitoringSpec) DeepCopy() *MonitoringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
+	*out = *in
+	if in.RestrictEgress != nil {
+		in, out := &in.RestrictEgress, &out.RestrictEgress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IngressFrom != nil {
+		in, out := &in.IngressFrom, &out.IngressFrom
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicySpec.
+func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenShiftSpec) DeepCopyInto(out *OpenShiftSpec) {
+	*out = *in
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(RouteSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenShiftSpec.
+func (in *OpenShiftSpec) DeepCopy() *OpenShiftSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenShiftSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDBConfig) DeepCopyInto(out *PDBConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDBConfig.
+func (in *PDBConfig) DeepCopy() *PDBConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PDBConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbesSpec) DeepCopyInto(out *ProbesSpec) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Liveness != nil {
+		in, out := &in.Liveness, &out.Liveness
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Startup != nil {
+		in, out := &in.Startup, &out.Startup
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbesSpec.
+func (in *ProbesSpec) DeepCopy() *ProbesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelabelConfig) DeepCopyInto(out *RelabelConfig) {
+	*out = *in
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelabelConfig.
+func (in *RelabelConfig) DeepCopy() *RelabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RelabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLSTermination != nil {
+		in, out := &in.TLSTermination, &out.TLSTermination
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3ArtifactStoreSpec) DeepCopyInto(out *S3ArtifactStoreSpec) {
+	*out = *in
+	if in.EndpointURL != nil {
+		in, out := &in.EndpointURL, &out.EndpointURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForcePathStyle != nil {
+		in, out := &in.ForcePathStyle, &out.ForcePathStyle
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InsecureSkipVerify != nil {
+		in, out := &in.InsecureSkipVerify, &out.InsecureSkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AssumeRoleARN != nil {
+		in, out := &in.AssumeRoleARN, &out.AssumeRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceRoleARN != nil {
+		in, out := &in.SourceRoleARN, &out.SourceRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalID != nil {
+		in, out := &in.ExternalID, &out.ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessKeyIDSecret != nil {
+		in, out := &in.AccessKeyIDSecret, &out.AccessKeyIDSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretAccessKeySecret != nil {
+		in, out := &in.SecretAccessKeySecret, &out.SecretAccessKeySecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SessionTokenSecret != nil {
+		in, out := &in.SessionTokenSecret, &out.SessionTokenSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3ArtifactStoreSpec.
+func (in *S3ArtifactStoreSpec) DeepCopy() *S3ArtifactStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(S3ArtifactStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerTimeoutsConfig) DeepCopyInto(out *ServerTimeoutsConfig) {
+	*out = *in
+	if in.KeepAliveSeconds != nil {
+		in, out := &in.KeepAliveSeconds, &out.KeepAliveSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GracefulShutdownSeconds != nil {
+		in, out := &in.GracefulShutdownSeconds, &out.GracefulShutdownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerTimeoutsConfig.
+func (in *ServerTimeoutsConfig) DeepCopy() *ServerTimeoutsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerTimeoutsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenConfig) DeepCopyInto(out *ServiceAccountTokenConfig) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenConfig.
+func (in *ServiceAccountTokenConfig) DeepCopy() *ServiceAccountTokenConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(corev1.ServiceType)
+		**out = **in
+	}
+	if in.NodePort != nil {
+		in, out := &in.NodePort, &out.NodePort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LoadBalancerIP != nil {
+		in, out := &in.LoadBalancerIP, &out.LoadBalancerIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headless != nil {
+		in, out := &in.Headless, &out.Headless
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PortName != nil {
+		in, out := &in.PortName, &out.PortName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpec.
+func (in *ServiceSpec) DeepCopy() *ServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
+	*out = *in
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSpec.
+func (in *TLSSpec) DeepCopy() *TLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TraceArchivalSpec) DeepCopyInto(out *TraceArchivalSpec) {
 	*out = *in
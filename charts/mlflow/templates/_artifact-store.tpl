@@ -0,0 +1,66 @@
+{{/*
+Artifact store client environment variables.
+Shared by every container that reads or writes artifacts directly.
+Usage: {{- include "mlflow.artifactStoreEnv" . | nindent 12 }}
+*/}}
+{{- define "mlflow.artifactStoreEnv" -}}
+{{- if .Values.artifactStore.s3.endpointUrl }}
+- name: MLFLOW_S3_ENDPOINT_URL
+  value: {{ .Values.artifactStore.s3.endpointUrl | quote }}
+{{- end }}
+{{- if .Values.artifactStore.s3.forcePathStyle }}
+- name: MLFLOW_BOTO_CLIENT_ADDRESSING_STYLE
+  value: "path"
+{{- end }}
+{{- if .Values.artifactStore.s3.insecureSkipVerify }}
+- name: MLFLOW_S3_IGNORE_TLS
+  value: "true"
+{{- end }}
+{{- if .Values.artifactStore.s3.assumeRole.roleArn }}
+- name: AWS_CONFIG_FILE
+  value: "/etc/mlflow-aws/config"
+- name: AWS_PROFILE
+  value: "mlflow-artifacts"
+{{- end }}
+{{- with .Values.artifactStore.s3.credentials }}
+{{- with .accessKeyId }}
+- name: AWS_ACCESS_KEY_ID
+  valueFrom:
+    {{- toYaml . | nindent 4 }}
+{{- end }}
+{{- with .secretAccessKey }}
+- name: AWS_SECRET_ACCESS_KEY
+  valueFrom:
+    {{- toYaml . | nindent 4 }}
+{{- end }}
+{{- with .sessionToken }}
+- name: AWS_SESSION_TOKEN
+  valueFrom:
+    {{- toYaml . | nindent 4 }}
+{{- end }}
+{{- end }}
+{{- end -}}
+
+{{/*
+Artifact store client volumes.
+Usage: {{- include "mlflow.artifactStoreVolumes" . | nindent 8 }}
+*/}}
+{{- define "mlflow.artifactStoreVolumes" -}}
+{{- if .Values.artifactStore.s3.assumeRole.roleArn }}
+- name: aws-config
+  configMap:
+    name: mlflow-aws-config{{ .Values.resourceSuffix }}
+{{- end }}
+{{- end -}}
+
+{{/*
+Artifact store client volume mounts.
+Usage: {{- include "mlflow.artifactStoreVolumeMounts" . | nindent 12 }}
+*/}}
+{{- define "mlflow.artifactStoreVolumeMounts" -}}
+{{- if .Values.artifactStore.s3.assumeRole.roleArn }}
+- name: aws-config
+  mountPath: /etc/mlflow-aws
+  readOnly: true
+{{- end }}
+{{- end -}}
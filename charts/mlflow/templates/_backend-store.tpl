@@ -0,0 +1,20 @@
+{{/*
+MLFLOW_BACKEND_STORE_URI environment variable, from a Secret reference or a literal.
+When the URI was assembled from database.connection fields, the password is passed
+separately through PGPASSWORD so it never has to be URL-encoded into the URI.
+Usage: {{- include "mlflow.backendStoreUriEnv" . | nindent 12 }}
+*/}}
+{{- define "mlflow.backendStoreUriEnv" -}}
+- name: MLFLOW_BACKEND_STORE_URI
+  {{- if .Values.mlflow.backendStoreUriFrom }}
+  valueFrom:
+    {{- toYaml .Values.mlflow.backendStoreUriFrom | nindent 4 }}
+  {{- else }}
+  value: {{ .Values.mlflow.backendStoreUri | quote }}
+  {{- end }}
+{{- with .Values.mlflow.backendStorePasswordFrom }}
+- name: PGPASSWORD
+  valueFrom:
+    {{- toYaml . | nindent 4 }}
+{{- end }}
+{{- end -}}
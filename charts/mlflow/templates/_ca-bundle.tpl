@@ -44,9 +44,15 @@ Usage: {{ include "mlflow.caBundleVolumes" . | nindent 8 }}
 {{- if .Values.caBundle.configMaps }}
 {{- range $i, $cm := .Values.caBundle.configMaps }}
 - name: ca-bundle-{{ $i }}
+  {{- if $cm.secret }}
+  secret:
+    secretName: {{ $cm.name }}
+    optional: true
+  {{- else }}
   configMap:
     name: {{ $cm.name }}
     optional: true
+  {{- end }}
 {{- end }}
 - name: combined-ca-bundle
   emptyDir: {}
@@ -60,49 +66,58 @@ Usage: {{ include "mlflow.caBundleInitContainers" . | nindent 6 }}
 {{- define "mlflow.caBundleInitContainers" -}}
 {{- if .Values.caBundle.configMaps }}
 initContainers:
-  - name: combine-ca-bundles
-    image: {{ .Values.image.name }}
-    {{- if .Values.image.imagePullPolicy }}
-    imagePullPolicy: {{ .Values.image.imagePullPolicy }}
-    {{- end }}
-    command:
-      - /bin/sh
-      - -c
-      - |
-        set -e
-{{ include "mlflow.caBundleFunctions" . | indent 8 }}
-        combine_ca_bundles
-    env:
-      - name: CA_BUNDLE_FILE_PATHS
-        value: {{ include "mlflow.caBundleFilePaths" . | quote }}
-      - name: CA_BUNDLE_MOUNT_PATHS
-        value: {{ include "mlflow.caBundleMountPaths" . | quote }}
-      - name: CA_BUNDLE_OUTPUT
-        value: {{ .Values.caBundle.outputPath | quote }}
-    volumeMounts:
-      - name: tmp
-        mountPath: /tmp
-      - name: combined-ca-bundle
-        mountPath: {{ dir .Values.caBundle.outputPath }}
-      {{- range $i, $cm := .Values.caBundle.configMaps }}
-      - name: ca-bundle-{{ $i }}
-        mountPath: {{ $cm.mountPath }}
-        readOnly: true
-      {{- end }}
-    {{- with .Values.securityContext }}
-    securityContext:
-      {{- toYaml . | nindent 6 }}
-    {{- end }}
-    resources:
-      requests:
-        cpu: 10m
-        memory: 16Mi
-      limits:
-        cpu: 100m
-        memory: 64Mi
+  {{- include "mlflow.caBundleInitContainer" . | nindent 2 }}
 {{- end }}
 {{- end -}}
 
+{{/*
+The combine-ca-bundles init container as a single list item, for pods that
+run other init containers too.
+*/}}
+{{- define "mlflow.caBundleInitContainer" -}}
+- name: combine-ca-bundles
+  image: {{ .Values.image.name }}
+  {{- if .Values.image.imagePullPolicy }}
+  imagePullPolicy: {{ .Values.image.imagePullPolicy }}
+  {{- end }}
+  terminationMessagePolicy: {{ .Values.terminationMessagePolicy | default "FallbackToLogsOnError" }}
+  command:
+    - /bin/sh
+    - -c
+    - |
+      set -e
+{{ include "mlflow.caBundleFunctions" . | indent 6 }}
+      combine_ca_bundles
+  env:
+    - name: CA_BUNDLE_FILE_PATHS
+      value: {{ include "mlflow.caBundleFilePaths" . | quote }}
+    - name: CA_BUNDLE_MOUNT_PATHS
+      value: {{ include "mlflow.caBundleMountPaths" . | quote }}
+    - name: CA_BUNDLE_OUTPUT
+      value: {{ .Values.caBundle.outputPath | quote }}
+  volumeMounts:
+    - name: tmp
+      mountPath: /tmp
+    - name: combined-ca-bundle
+      mountPath: {{ dir .Values.caBundle.outputPath }}
+    {{- range $i, $cm := .Values.caBundle.configMaps }}
+    - name: ca-bundle-{{ $i }}
+      mountPath: {{ $cm.mountPath }}
+      readOnly: true
+    {{- end }}
+  {{- with .Values.securityContext }}
+  securityContext:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  resources:
+    requests:
+      cpu: 10m
+      memory: 16Mi
+    limits:
+      cpu: 100m
+      memory: 64Mi
+{{- end -}}
+
 {{- define "mlflow.caBundleFunctions" -}}
 # Compute checksum of CA bundle source files
 compute_checksum() {
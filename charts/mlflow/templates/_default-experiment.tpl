@@ -0,0 +1,78 @@
+{{/*
+Init container that creates defaultExperiment.name in the backend store when it
+does not exist. Runs after combine-ca-bundles so database TLS uses the combined bundle.
+Usage: {{- include "mlflow.defaultExperimentInitContainer" . | nindent 8 }}
+*/}}
+{{- define "mlflow.defaultExperimentInitContainer" -}}
+- name: create-default-experiment
+  image: {{ .Values.image.name }}
+  {{- if .Values.image.imagePullPolicy }}
+  imagePullPolicy: {{ .Values.image.imagePullPolicy }}
+  {{- end }}
+  terminationMessagePolicy: {{ .Values.terminationMessagePolicy | default "FallbackToLogsOnError" }}
+  command:
+    - /bin/sh
+    - -ec
+  args:
+    - {{ .Values.defaultExperiment.command | quote }}
+  env:
+    {{- include "mlflow.backendStoreUriEnv" . | nindent 4 }}
+    - name: MLFLOW_DEFAULT_EXPERIMENT_NAME
+      value: {{ .Values.defaultExperiment.name | quote }}
+    - name: MLFLOW_DEFAULT_ARTIFACT_ROOT
+      {{- if .Values.mlflow.defaultArtifactRoot }}
+      value: {{ .Values.mlflow.defaultArtifactRoot | quote }}
+      {{- else }}
+      value: "mlflow-artifacts:/"
+      {{- end }}
+    - name: DEFAULT_EXPERIMENT_PYTHON_SCRIPT
+      value: {{ .Values.defaultExperiment.script | quote }}
+    {{- if .Values.caBundle.configMaps }}
+    - name: SSL_CERT_FILE
+      value: {{ .Values.caBundle.outputPath | quote }}
+    {{- if .Values.caBundle.databaseTLS }}
+    - name: PGSSLROOTCERT
+      value: {{ .Values.caBundle.outputPath | quote }}
+    - name: PGSSLMODE
+      value: "verify-full"
+    - name: MLFLOW_MYSQL_CA
+      value: {{ .Values.caBundle.outputPath | quote }}
+    {{- end }}
+    {{- end }}
+    {{- range .Values.env }}
+    - name: {{ .name }}
+      {{- if .valueFrom }}
+      valueFrom:
+        {{- toYaml .valueFrom | nindent 8 }}
+      {{- else }}
+      value: {{ .value | quote }}
+      {{- end }}
+    {{- end }}
+  {{- if .Values.envFrom }}
+  envFrom:
+    {{- toYaml .Values.envFrom | nindent 4 }}
+  {{- end }}
+  volumeMounts:
+    - name: tmp
+      mountPath: /tmp
+    {{- if .Values.storage.enabled }}
+    - name: mlflow-storage
+      mountPath: /mlflow
+    {{- end }}
+    {{- if .Values.caBundle.configMaps }}
+    - name: combined-ca-bundle
+      mountPath: {{ dir .Values.caBundle.outputPath }}
+      readOnly: true
+    {{- end }}
+  {{- with .Values.securityContext }}
+  securityContext:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  resources:
+    requests:
+      cpu: 50m
+      memory: 128Mi
+    limits:
+      cpu: 500m
+      memory: 512Mi
+{{- end -}}
@@ -0,0 +1,15 @@
+{{/*
+Container probe body from a probes.<kind> values map. When the map sets no
+handler, an HTTPS GET on the given path is added so overrides may tune
+timings alone.
+Usage: {{- include "mlflow.probe" (dict "probe" .Values.probes.liveness "path" $path) | nindent 12 }}
+*/}}
+{{- define "mlflow.probe" -}}
+{{- if not (or .probe.httpGet .probe.tcpSocket .probe.exec .probe.grpc) -}}
+httpGet:
+  path: {{ .path }}
+  port: https
+  scheme: HTTPS
+{{ end -}}
+{{- toYaml .probe }}
+{{- end -}}
@@ -0,0 +1,8 @@
+{{/*
+Name of the PVC mounted as mlflow-storage: storage.existingClaim when set,
+otherwise the chart's own PVC, named storage.claimName or mlflow-pvc.
+Usage: {{ include "mlflow.storageClaimName" . }}
+*/}}
+{{- define "mlflow.storageClaimName" -}}
+{{- .Values.storage.existingClaim | default .Values.storage.claimName | default (printf "mlflow-pvc%s" .Values.resourceSuffix) -}}
+{{- end -}}
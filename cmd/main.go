@@ -30,11 +30,15 @@ import (
 
 	configv1 "github.com/openshift/api/config/v1"
 	consolev1 "github.com/openshift/api/console/v1"
+	routev1 "github.com/openshift/api/route/v1"
 	tlspkg "github.com/openshift/controller-runtime-common/pkg/tls"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
@@ -94,6 +98,7 @@ func init() {
 	utilruntime.Must(configv1.Install(scheme))
 	utilruntime.Must(consolev1.AddToScheme(scheme))
 	utilruntime.Must(monitoringv1.AddToScheme(scheme))
+	utilruntime.Must(routev1.Install(scheme))
 	utilruntime.Must(gatewayv1.Install(scheme))
 	// +kubebuilder:scaffold:scheme
 }
@@ -106,11 +111,15 @@ func inferPodNamespace() string {
 }
 
 func resolveManagerNamespace(namespace string, operatorConfig *config.OperatorConfig) string {
-	if operatorConfig != nil &&
-		operatorConfig.EnableMLflowOperatorModuleController &&
-		operatorConfig.ApplicationsNamespace != "" {
+	if operatorConfig == nil {
+		return namespace
+	}
+	if operatorConfig.EnableMLflowOperatorModuleController && operatorConfig.ApplicationsNamespace != "" {
 		return operatorConfig.ApplicationsNamespace
 	}
+	if operatorConfig.WatchNamespace != "" {
+		return operatorConfig.WatchNamespace
+	}
 	return namespace
 }
 
@@ -302,13 +311,17 @@ func main() {
 
 	// Build the ByObject cache configuration
 	byObjectCache := map[client.Object]cache.ByObject{
-		&appsv1.Deployment{}:            {Label: labelSelector},
-		&batchv1.Job{}:                  {Label: migrationJobLabelSelector},
-		&corev1.Pod{}:                   {Label: migrationJobLabelSelector},
-		&corev1.Secret{}:                {Label: labelSelector},
-		&corev1.Service{}:               {Label: labelSelector},
-		&corev1.ServiceAccount{}:        {Label: labelSelector},
-		&corev1.PersistentVolumeClaim{}: {Label: labelSelector},
+		&appsv1.Deployment{}:                     {Label: labelSelector},
+		&appsv1.ReplicaSet{}:                     {Label: labelSelector},
+		&autoscalingv2.HorizontalPodAutoscaler{}: {Label: labelSelector},
+		&batchv1.Job{}:                           {Label: migrationJobLabelSelector},
+		&corev1.Pod{}:                            {Label: migrationJobLabelSelector},
+		&corev1.Secret{}:                         {Label: labelSelector},
+		&corev1.Service{}:                        {Label: labelSelector},
+		&corev1.ServiceAccount{}:                 {Label: labelSelector},
+		&corev1.PersistentVolumeClaim{}:          {Label: labelSelector},
+		&networkingv1.Ingress{}:                  {Label: labelSelector},
+		&policyv1.PodDisruptionBudget{}:          {Label: labelSelector},
 		// Use metadata.name field selectors so list/watch authorization stays aligned with
 		// resourceNames-scoped RBAC for the shared server ClusterRole/ClusterRoleBinding.
 		&rbacv1.ClusterRole{}:        {Field: sharedClusterRoleFieldSelector},
@@ -337,6 +350,17 @@ func main() {
 		setupLog.Info("HTTPRoute CRD not available, skipping cache configuration")
 	}
 
+	// Conditionally add Route to cache if available
+	routeAvailable, err := controller.IsRouteAvailable(discoveryClient)
+	if err != nil {
+		setupLog.Error(err, "Failed to check Route availability")
+	} else if routeAvailable {
+		setupLog.Info("Route API available, adding to cache with label selector")
+		byObjectCache[&routev1.Route{}] = cache.ByObject{Label: labelSelector}
+	} else {
+		setupLog.Info("Route API not available, skipping cache configuration")
+	}
+
 	// Conditionally add ServiceMonitor to cache if available
 	serviceMonitorAvailable, err := controller.IsServiceMonitorAvailable(discoveryClient)
 	if err != nil {
@@ -397,14 +421,15 @@ func main() {
 	}
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
-		Scheme:                 scheme,
-		Metrics:                metricsServerOptions,
-		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "a5eb1b3b.opendatahub.io",
-		LeaseDuration:          &leaseDuration,
-		RenewDeadline:          &renewDeadline,
-		RetryPeriod:            &retryPeriod,
+		Scheme:                  scheme,
+		Metrics:                 metricsServerOptions,
+		HealthProbeBindAddress:  probeAddr,
+		LeaderElection:          enableLeaderElection,
+		LeaderElectionID:        "a5eb1b3b.opendatahub.io",
+		LeaderElectionNamespace: operatorConfig.LeaderElectionNamespace,
+		LeaseDuration:           &leaseDuration,
+		RenewDeadline:           &renewDeadline,
+		RetryPeriod:             &retryPeriod,
 		// Cache configuration to limit watch scope to deployment namespace and MLflow-owned resources
 		Cache: cache.Options{
 			// Limit owned resources to the target namespace only
@@ -448,15 +473,32 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Watch the metadata of all Secrets and ConfigMaps in the target namespace through a
+	// dedicated cache. The objects an MLflow spec references, such as database and S3
+	// credentials, are created by users and lack the app=mlflow label the manager cache
+	// filters on.
+	referencedObjectWatchCache, err := controller.NewReferencedObjectWatchCache(cfg, scheme, namespace)
+	if err != nil {
+		setupLog.Error(err, "unable to create referenced object watch cache")
+		os.Exit(1)
+	}
+	if err := mgr.Add(referencedObjectWatchCache); err != nil {
+		setupLog.Error(err, "unable to add referenced object watch cache")
+		os.Exit(1)
+	}
+
 	if err := (&controller.MLflowReconciler{
-		Client:                  mgr.GetClient(),
-		Scheme:                  mgr.GetScheme(),
-		Namespace:               namespace,
-		ChartPath:               "charts/mlflow",
-		ConsoleLinkAvailable:    consoleLinkAvailable,
-		HTTPRouteAvailable:      httpRouteAvailable,
-		ServiceMonitorAvailable: serviceMonitorAvailable,
-		GCRBACWatchCache:        gcRBACWatchCache,
+		Client:                     mgr.GetClient(),
+		Scheme:                     mgr.GetScheme(),
+		Namespace:                  namespace,
+		ChartPath:                  "charts/mlflow",
+		ConsoleLinkAvailable:       consoleLinkAvailable,
+		HTTPRouteAvailable:         httpRouteAvailable,
+		ServiceMonitorAvailable:    serviceMonitorAvailable,
+		RouteAvailable:             routeAvailable,
+		GCRBACWatchCache:           gcRBACWatchCache,
+		ReferencedObjectWatchCache: referencedObjectWatchCache,
+		APIReader:                  mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MLflow")
 		os.Exit(1)
@@ -107,6 +107,24 @@ func TestResolveManagerNamespace(t *testing.T) {
 			},
 			expectedNamespace: "opendatahub",
 		},
+		{
+			name:      "uses watch namespace when set",
+			namespace: "opendatahub",
+			operatorConfig: &config.OperatorConfig{
+				WatchNamespace: "mlflow-team-a",
+			},
+			expectedNamespace: "mlflow-team-a",
+		},
+		{
+			name:      "applications namespace wins over watch namespace",
+			namespace: "opendatahub",
+			operatorConfig: &config.OperatorConfig{
+				ApplicationsNamespace:                "redhat-ods-applications",
+				EnableMLflowOperatorModuleController: true,
+				WatchNamespace:                       "mlflow-team-a",
+			},
+			expectedNamespace: "redhat-ods-applications",
+		},
 		{
 			name:              "falls back when config missing",
 			namespace:         "opendatahub",
@@ -18,6 +18,7 @@ package config
 
 import (
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,6 +29,12 @@ const (
 	DefaultMLflowURL                    = "https://mlflow.example.com"
 	DefaultMLflowOperatorCRDWaitTimeout = 30 * time.Second
 	DefaultAuthCRDWaitTimeout           = 30 * time.Second
+
+	// DeploymentModeODH and DeploymentModeRHOAI are the production platform
+	// installs. Any other DEPLOYMENT_MODE, including none, is a standalone
+	// install.
+	DeploymentModeODH   = "odh"
+	DeploymentModeRHOAI = "rhoai"
 )
 
 // OperatorConfig holds the configuration for the MLflow operator
@@ -56,6 +63,21 @@ type OperatorConfig struct {
 	AuthCRDWaitTimeout time.Duration
 	// ResourceNamePrefix is the kustomize namePrefix applied to cluster-scoped resources at deploy time
 	ResourceNamePrefix string
+	// WatchNamespace overrides the namespace the operator caches, watches, and
+	// creates MLflow operands in. When empty, the --namespace flag is used.
+	WatchNamespace string
+	// LeaderElectionNamespace is the namespace of the leader election Lease.
+	// When empty, controller-runtime uses the operator pod's namespace.
+	LeaderElectionNamespace string
+	// DeploymentMode is the platform the operator is installed for:
+	// DeploymentModeODH, DeploymentModeRHOAI, or empty for a standalone install.
+	DeploymentMode string
+}
+
+// IsProductionMode reports whether the operator is installed for a production
+// platform, where MLflow serves many users and needs a database server.
+func (c *OperatorConfig) IsProductionMode() bool {
+	return c.DeploymentMode == DeploymentModeODH || c.DeploymentMode == DeploymentModeRHOAI
 }
 
 var (
@@ -87,6 +109,9 @@ func loadConfig(v *viper.Viper, lookupEnv envLookupFn) *OperatorConfig {
 		EnableNamespaceRBAC:                  v.GetBool("ENABLE_NAMESPACE_RBAC"),
 		AuthCRDWaitTimeout:                   v.GetDuration("AUTH_CRD_WAIT_TIMEOUT"),
 		ResourceNamePrefix:                   v.GetString("RESOURCE_NAME_PREFIX"),
+		WatchNamespace:                       v.GetString("WATCH_NAMESPACE"),
+		LeaderElectionNamespace:              v.GetString("LEADER_ELECTION_NAMESPACE"),
+		DeploymentMode:                       strings.ToLower(v.GetString("DEPLOYMENT_MODE")),
 	}
 }
 
@@ -107,6 +132,9 @@ func GetConfig() *OperatorConfig {
 		v.SetDefault("ENABLE_NAMESPACE_RBAC", false)
 		v.SetDefault("AUTH_CRD_WAIT_TIMEOUT", DefaultAuthCRDWaitTimeout)
 		v.SetDefault("RESOURCE_NAME_PREFIX", "mlflow-operator-")
+		v.SetDefault("WATCH_NAMESPACE", "")
+		v.SetDefault("LEADER_ELECTION_NAMESPACE", "")
+		v.SetDefault("DEPLOYMENT_MODE", "")
 
 		instance = loadConfig(v, os.LookupEnv)
 	})
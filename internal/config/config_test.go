@@ -53,6 +53,47 @@ func TestLoadConfigFallsBackToLegacyInputs(t *testing.T) {
 	}
 }
 
+func TestLoadConfigNamespaceScoping(t *testing.T) {
+	cfg := loadConfig(newTestViper(), os.LookupEnv)
+	if cfg.WatchNamespace != "" || cfg.LeaderElectionNamespace != "" {
+		t.Fatalf("expected namespace scoping to default to unset, got watch=%q leaderElection=%q",
+			cfg.WatchNamespace, cfg.LeaderElectionNamespace)
+	}
+
+	t.Setenv("WATCH_NAMESPACE", "mlflow-team-a")
+	t.Setenv("LEADER_ELECTION_NAMESPACE", "mlflow-operator-system")
+
+	cfg = loadConfig(newTestViper(), os.LookupEnv)
+
+	if cfg.WatchNamespace != "mlflow-team-a" {
+		t.Fatalf("expected watch namespace override, got %q", cfg.WatchNamespace)
+	}
+	if cfg.LeaderElectionNamespace != "mlflow-operator-system" {
+		t.Fatalf("expected leader election namespace override, got %q", cfg.LeaderElectionNamespace)
+	}
+}
+
+func TestLoadConfigDeploymentMode(t *testing.T) {
+	cfg := loadConfig(newTestViper(), os.LookupEnv)
+	if cfg.DeploymentMode != "" || cfg.IsProductionMode() {
+		t.Fatalf("expected a standalone install by default, got mode %q", cfg.DeploymentMode)
+	}
+
+	for _, mode := range []string{"odh", "RHOAI"} {
+		t.Setenv("DEPLOYMENT_MODE", mode)
+		cfg = loadConfig(newTestViper(), os.LookupEnv)
+		if !cfg.IsProductionMode() {
+			t.Fatalf("expected DEPLOYMENT_MODE=%s to be a production mode, got %q", mode, cfg.DeploymentMode)
+		}
+	}
+
+	t.Setenv("DEPLOYMENT_MODE", "kind")
+	cfg = loadConfig(newTestViper(), os.LookupEnv)
+	if cfg.IsProductionMode() {
+		t.Fatalf("expected DEPLOYMENT_MODE=kind not to be a production mode")
+	}
+}
+
 func TestResourceNamePrefixMatchesKustomize(t *testing.T) {
 	_, thisFile, _, _ := runtime.Caller(0)
 	repoRoot := filepath.Join(filepath.Dir(thisFile), "..", "..")
@@ -90,5 +131,8 @@ func newTestViper() *viper.Viper {
 	v.SetDefault("ENABLE_NAMESPACE_RBAC", false)
 	v.SetDefault("AUTH_CRD_WAIT_TIMEOUT", DefaultAuthCRDWaitTimeout)
 	v.SetDefault("RESOURCE_NAME_PREFIX", "mlflow-operator-")
+	v.SetDefault("WATCH_NAMESPACE", "")
+	v.SetDefault("LEADER_ELECTION_NAMESPACE", "")
+	v.SetDefault("DEPLOYMENT_MODE", "")
 	return v
 }
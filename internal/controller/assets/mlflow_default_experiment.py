@@ -0,0 +1,44 @@
+import os
+import sys
+
+from mlflow.exceptions import MlflowException
+from mlflow.protos.databricks_pb2 import RESOURCE_ALREADY_EXISTS, ErrorCode
+from mlflow.store.tracking.sqlalchemy_store import SqlAlchemyStore
+
+
+def supports_sql_store(uri):
+    dialect = uri.split(":", 1)[0].split("+", 1)[0]
+    return dialect in ("sqlite", "postgresql", "mysql")
+
+
+def main():
+    name = os.environ.get("MLFLOW_DEFAULT_EXPERIMENT_NAME", "").strip()
+    backend_uri = os.environ.get("MLFLOW_BACKEND_STORE_URI", "").strip()
+    artifact_root = os.environ.get("MLFLOW_DEFAULT_ARTIFACT_ROOT", "").strip()
+
+    if not name:
+        print("No default experiment configured")
+        return 0
+    if not supports_sql_store(backend_uri):
+        print("Skipping default experiment creation: backend store is not a SQL database", file=sys.stderr)
+        return 0
+
+    store = SqlAlchemyStore(backend_uri, artifact_root)
+    if store.get_experiment_by_name(name) is not None:
+        print(f"Default experiment {name!r} already exists")
+        return 0
+
+    try:
+        experiment_id = store.create_experiment(name)
+    except MlflowException as exc:
+        # Another replica created it between the lookup and the insert.
+        if exc.error_code == ErrorCode.Name(RESOURCE_ALREADY_EXISTS):
+            print(f"Default experiment {name!r} already exists")
+            return 0
+        raise
+    print(f"Created default experiment {name!r} with ID {experiment_id}")
+    return 0
+
+
+if __name__ == "__main__":
+    raise SystemExit(main())
@@ -0,0 +1,181 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	routev1 "github.com/openshift/api/route/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// optionalResource is an object the chart renders only while enabled reports
+// true. deleteDisabledResources removes it once the feature is turned off.
+type optionalResource struct {
+	kind    string
+	enabled func(mlflow *mlflowv1.MLflow) bool
+	obj     client.Object
+	// reader looks the object up before it is deleted, so reconciles do not
+	// issue a DELETE for objects that were never created. It is nil for
+	// objects the label-filtered manager cache does not hold; those are
+	// deleted directly.
+	reader client.Reader
+}
+
+// optionalResources lists the objects of mlflow that only exist while a
+// feature is enabled.
+func (r *MLflowReconciler) optionalResources(mlflow *mlflowv1.MLflow, namespace string) []optionalResource {
+	suffix := getResourceSuffix(mlflow.Name)
+	objectMeta := func(name, namespace string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: namespace}
+	}
+	gcEnabled := func(mlflow *mlflowv1.MLflow) bool { return mlflow.Spec.GarbageCollection != nil }
+	clientTokenEnabled := func(mlflow *mlflowv1.MLflow) bool { return mlflow.Spec.ClientToken != nil }
+
+	return []optionalResource{
+		// The GC and trace archival ServiceAccounts carry their own app label,
+		// which the manager cache filters out.
+		{kind: "CronJob", enabled: gcEnabled, reader: r.Client,
+			obj: &batchv1.CronJob{ObjectMeta: objectMeta(ResourceName+"-gc"+suffix, namespace)}},
+		{kind: "ServiceAccount", enabled: gcEnabled,
+			obj: &corev1.ServiceAccount{ObjectMeta: objectMeta(GCServiceAccountName, namespace)}},
+		{kind: "ClusterRoleBinding", enabled: gcEnabled, reader: r.gcRBACReader(),
+			obj: &rbacv1.ClusterRoleBinding{ObjectMeta: objectMeta(ResourceName+"-gc"+suffix, "")}},
+		{kind: "ClusterRole", enabled: gcEnabled, reader: r.gcRBACReader(),
+			obj: &rbacv1.ClusterRole{ObjectMeta: objectMeta(ResourceName+"-gc"+suffix, "")}},
+
+		{kind: "CronJob", enabled: isTraceArchivalEnabled, reader: r.Client,
+			obj: &batchv1.CronJob{ObjectMeta: objectMeta(ResourceName+"-trace-archival"+suffix, namespace)}},
+		{kind: "ServiceAccount", enabled: isTraceArchivalEnabled,
+			obj: &corev1.ServiceAccount{ObjectMeta: objectMeta(TraceArchivalServiceAccountName, namespace)}},
+		{kind: "ConfigMap", enabled: isTraceArchivalEnabled, reader: r.Client,
+			obj: &corev1.ConfigMap{ObjectMeta: objectMeta("mlflow-trace-archival-config"+suffix, namespace)}},
+
+		{kind: "Service", reader: r.Client,
+			enabled: func(mlflow *mlflowv1.MLflow) bool {
+				return mlflow.Spec.Service != nil && mlflow.Spec.Service.Headless != nil && *mlflow.Spec.Service.Headless
+			},
+			obj: &corev1.Service{ObjectMeta: objectMeta(ResourceName+"-headless"+suffix, namespace)}},
+		{kind: "HorizontalPodAutoscaler", reader: r.Client,
+			enabled: func(mlflow *mlflowv1.MLflow) bool {
+				return mlflow.Spec.Autoscaling != nil && mlflow.Spec.Autoscaling.Enabled != nil && *mlflow.Spec.Autoscaling.Enabled
+			},
+			obj: &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: objectMeta(ResourceName+suffix, namespace)}},
+		// The automatic budget also goes away when the Deployment scales to one replica.
+		{kind: "PodDisruptionBudget", enabled: podDisruptionBudgetEnabled, reader: r.Client,
+			obj: &policyv1.PodDisruptionBudget{ObjectMeta: objectMeta(ResourceName+suffix, namespace)}},
+		// Without the Route API there is no Route to look up.
+		{kind: "Route", reader: r.Client,
+			enabled: func(mlflow *mlflowv1.MLflow) bool {
+				return !r.RouteAvailable || (mlflow.Spec.OpenShift != nil && mlflow.Spec.OpenShift.Route != nil &&
+					mlflow.Spec.OpenShift.Route.Enabled != nil && *mlflow.Spec.OpenShift.Route.Enabled)
+			},
+			obj: &routev1.Route{ObjectMeta: objectMeta(ResourceName+suffix, namespace)}},
+		{kind: "Ingress", reader: r.Client,
+			enabled: func(mlflow *mlflowv1.MLflow) bool {
+				return mlflow.Spec.Ingress != nil && mlflow.Spec.Ingress.Enabled != nil && *mlflow.Spec.Ingress.Enabled
+			},
+			obj: &networkingv1.Ingress{ObjectMeta: objectMeta(ResourceName+suffix, namespace)}},
+
+		// Left in place, the Certificate keeps rewriting the TLS Secret that
+		// the service-ca operator populates again once certManager is disabled.
+		{kind: "Certificate", enabled: certManagerEnabled, reader: r.Client,
+			obj: certificateObject(ResourceName+suffix, namespace)},
+
+		{kind: "ConfigMap", reader: r.Client,
+			enabled: func(mlflow *mlflowv1.MLflow) bool {
+				return mlflow.Spec.ArtifactStore != nil && mlflow.Spec.ArtifactStore.S3 != nil &&
+					mlflow.Spec.ArtifactStore.S3.AssumeRoleARN != nil
+			},
+			obj: &corev1.ConfigMap{ObjectMeta: objectMeta("mlflow-aws-config"+suffix, namespace)}},
+		{kind: "ConfigMap", enabled: effectiveConfigEnabled, reader: r.Client,
+			obj: &corev1.ConfigMap{ObjectMeta: objectMeta(effectiveConfigMapName+suffix, namespace)}},
+		// Render-only reconciles return before cleanup, so the preview is
+		// removed as soon as the MLflow is applied again.
+		{kind: "ConfigMap", enabled: isRenderOnly, reader: r.Client,
+			obj: &corev1.ConfigMap{ObjectMeta: objectMeta(renderPreviewConfigMapName+suffix, namespace)}},
+
+		{kind: "Secret", enabled: clientTokenEnabled, reader: r.Client,
+			obj: &corev1.Secret{ObjectMeta: objectMeta(ClientTokenSecretName, namespace)}},
+		{kind: "ServiceAccount", enabled: clientTokenEnabled, reader: r.Client,
+			obj: &corev1.ServiceAccount{ObjectMeta: objectMeta(ClientServiceAccountName, namespace)}},
+	}
+}
+
+// certificateObject returns an empty cert-manager Certificate for lookups.
+// cert-manager types are not registered in the scheme.
+func certificateObject(name, namespace string) *unstructured.Unstructured {
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"})
+	certificate.SetName(name)
+	certificate.SetNamespace(namespace)
+	return certificate
+}
+
+// gcRBACReader returns the dedicated cache that holds the mlflow-gc
+// ClusterRole and ClusterRoleBinding; the main cache only holds the shared
+// mlflow RBAC objects.
+func (r *MLflowReconciler) gcRBACReader() client.Reader {
+	if r.GCRBACWatchCache != nil {
+		return r.GCRBACWatchCache
+	}
+	return r.Client
+}
+
+// deleteDisabledResources deletes the optional resources of mlflow whose
+// feature is turned off. Objects that are already gone are skipped.
+func (r *MLflowReconciler) deleteDisabledResources(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) error {
+	log := logf.FromContext(ctx)
+	for _, res := range r.optionalResources(mlflow, namespace) {
+		if res.enabled(mlflow) {
+			continue
+		}
+		key := client.ObjectKeyFromObject(res.obj)
+		if res.reader != nil {
+			if err := res.reader.Get(ctx, key, res.obj); err != nil {
+				if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+					continue
+				}
+				return fmt.Errorf("get %s %s: %w", res.kind, key.Name, err)
+			}
+		}
+		if err := r.Delete(ctx, res.obj); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			log.Error(err, "Failed to delete disabled resource", "kind", res.kind, "name", key.Name)
+			return fmt.Errorf("delete %s %s: %w", res.kind, key.Name, err)
+		}
+		log.Info("Deleted disabled resource", "kind", res.kind, "name", key.Name)
+	}
+	return nil
+}
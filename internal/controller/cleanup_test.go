@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestDeleteDisabledResources(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+
+	traceConfig := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "mlflow-trace-archival-config", Namespace: "test-ns"}}
+	tokenSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ClientTokenSecretName, Namespace: "test-ns"}}
+	certificate := certificateObject("mlflow", "test-ns")
+	var deleted []string
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(traceConfig, tokenSecret, certificate).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				deleted = append(deleted, obj.GetName())
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	r := &MLflowReconciler{Client: c, Scheme: scheme}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			ClientToken: &mlflowv1.ClientTokenSpec{},
+		},
+	}
+	g.Expect(r.deleteDisabledResources(ctx, mlflow, "test-ns")).To(gomega.Succeed())
+
+	// The config of the disabled trace archival and the Certificate left over
+	// from tls.certManager are deleted, and the enabled client token Secret
+	// is kept.
+	err := c.Get(ctx, client.ObjectKeyFromObject(traceConfig), &corev1.ConfigMap{})
+	g.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+	err = c.Get(ctx, client.ObjectKeyFromObject(certificate), certificateObject("mlflow", "test-ns"))
+	g.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(tokenSecret), &corev1.Secret{})).To(gomega.Succeed())
+
+	// Cached objects that do not exist are not deleted; only the
+	// ServiceAccounts outside the manager cache are deleted blindly.
+	g.Expect(deleted).To(gomega.ConsistOf("mlflow-trace-archival-config", "mlflow", GCServiceAccountName, TraceArchivalServiceAccountName))
+}
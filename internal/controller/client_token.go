@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// defaultClientTokenRotationPeriod is used when spec.clientToken.rotationPeriod is unset.
+const defaultClientTokenRotationPeriod = 30 * 24 * time.Hour
+
+func clientTokenRotationPeriod(mlflow *mlflowv1.MLflow) time.Duration {
+	if mlflow.Spec.ClientToken != nil && mlflow.Spec.ClientToken.RotationPeriod != nil {
+		return mlflow.Spec.ClientToken.RotationPeriod.Duration
+	}
+	return defaultClientTokenRotationPeriod
+}
+
+// clientTokenRotationDue reports whether a token Secret created at createdAt has
+// outlived period, and otherwise how long remains until it does.
+func clientTokenRotationDue(createdAt, now time.Time, period time.Duration) (bool, time.Duration) {
+	remaining := createdAt.Add(period).Sub(now)
+	if remaining <= 0 {
+		return true, 0
+	}
+	return false, remaining
+}
+
+// rotateClientToken deletes the client token Secret once it has outlived the
+// rotation period so the following apply re-issues it with a fresh token.
+// Deleting a service-account-token Secret invalidates the token it held.
+// It returns how long to wait before the next rotation check, or zero when
+// client tokens are disabled.
+func (r *MLflowReconciler) rotateClientToken(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) (time.Duration, error) {
+	if mlflow.Spec.ClientToken == nil {
+		return 0, nil
+	}
+	log := logf.FromContext(ctx)
+	period := clientTokenRotationPeriod(mlflow)
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: ClientTokenSecretName, Namespace: namespace}, secret)
+	if errors.IsNotFound(err) {
+		return period, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	due, remaining := clientTokenRotationDue(secret.CreationTimestamp.Time, time.Now(), period)
+	if !due {
+		return remaining, nil
+	}
+	if err := r.Delete(ctx, secret, client.Preconditions{UID: &secret.UID}); err != nil && !errors.IsNotFound(err) {
+		return 0, err
+	}
+	log.Info("Rotated client token Secret", "name", ClientTokenSecretName, "namespace", namespace, "rotationPeriod", period.String())
+	return period, nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestClientTokenRotationDue(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		now           time.Time
+		wantDue       bool
+		wantRemaining time.Duration
+	}{
+		{
+			name:          "fresh token is not due",
+			now:           created.Add(time.Hour),
+			wantRemaining: 23 * time.Hour,
+		},
+		{
+			name:    "token at the rotation period is due",
+			now:     created.Add(24 * time.Hour),
+			wantDue: true,
+		},
+		{
+			name:    "token past the rotation period is due",
+			now:     created.Add(48 * time.Hour),
+			wantDue: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			due, remaining := clientTokenRotationDue(created, tt.now, 24*time.Hour)
+			if due != tt.wantDue || remaining != tt.wantRemaining {
+				t.Errorf("clientTokenRotationDue() = (%v, %v), want (%v, %v)", due, remaining, tt.wantDue, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestRotateClientToken(t *testing.T) {
+	tokenSecret := func(age time.Duration) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              ClientTokenSecretName,
+				Namespace:         "test-ns",
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Type: corev1.SecretTypeServiceAccountToken,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		clientToken *mlflowv1.ClientTokenSpec
+		secret      *corev1.Secret
+		wantDeleted bool
+		wantRequeue func(time.Duration) bool
+	}{
+		{
+			name:        "disabled does nothing",
+			secret:      tokenSecret(48 * time.Hour),
+			wantRequeue: func(d time.Duration) bool { return d == 0 },
+		},
+		{
+			name:        "missing secret waits a full period",
+			clientToken: &mlflowv1.ClientTokenSpec{},
+			wantRequeue: func(d time.Duration) bool { return d == defaultClientTokenRotationPeriod },
+		},
+		{
+			name:        "fresh secret is kept until it expires",
+			clientToken: &mlflowv1.ClientTokenSpec{RotationPeriod: &metav1.Duration{Duration: 24 * time.Hour}},
+			secret:      tokenSecret(time.Hour),
+			wantRequeue: func(d time.Duration) bool { return d > 22*time.Hour && d <= 23*time.Hour },
+		},
+		{
+			name:        "expired secret is deleted for re-issue",
+			clientToken: &mlflowv1.ClientTokenSpec{RotationPeriod: &metav1.Duration{Duration: 24 * time.Hour}},
+			secret:      tokenSecret(25 * time.Hour),
+			wantDeleted: true,
+			wantRequeue: func(d time.Duration) bool { return d == 24*time.Hour },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			ctx := context.Background()
+			scheme := newTestScheme(t)
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tt.secret != nil {
+				builder = builder.WithObjects(tt.secret)
+			}
+			c := builder.Build()
+			r := &MLflowReconciler{Client: c, Scheme: scheme}
+
+			requeueAfter, err := r.rotateClientToken(ctx, &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec:       mlflowv1.MLflowSpec{ClientToken: tt.clientToken},
+			}, "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(tt.wantRequeue(requeueAfter)).To(gomega.BeTrue(), "unexpected requeue %v", requeueAfter)
+
+			if tt.secret == nil {
+				return
+			}
+			err = c.Get(ctx, types.NamespacedName{Name: ClientTokenSecretName, Namespace: "test-ns"}, &corev1.Secret{})
+			if tt.wantDeleted {
+				g.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			} else {
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestRenderChart_ClientToken(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "Secret", ClientTokenSecretName)).To(gomega.BeNil())
+	g.Expect(findObject(objs, "ServiceAccount", ClientServiceAccountName)).To(gomega.BeNil())
+
+	objs, err = renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			ClientToken:     &mlflowv1.ClientTokenSpec{},
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	sa := findObject(objs, "ServiceAccount", ClientServiceAccountName)
+	g.Expect(sa).NotTo(gomega.BeNil())
+	g.Expect(sa.GetLabels()).To(gomega.HaveKeyWithValue("app", "mlflow"))
+
+	secret := findObject(objs, "Secret", ClientTokenSecretName)
+	g.Expect(secret).NotTo(gomega.BeNil())
+	g.Expect(secret.GetNamespace()).To(gomega.Equal("test-ns"))
+	g.Expect(secret.Object["type"]).To(gomega.Equal(string(corev1.SecretTypeServiceAccountToken)))
+	g.Expect(secret.GetAnnotations()).To(gomega.HaveKeyWithValue(corev1.ServiceAccountNameKey, ClientServiceAccountName))
+	// The operator's Secret cache only sees objects labeled app=mlflow.
+	g.Expect(secret.GetLabels()).To(gomega.HaveKeyWithValue("app", "mlflow"))
+}
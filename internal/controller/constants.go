@@ -31,6 +31,10 @@ const (
 	GCServiceAccountName = "mlflow-gc-sa"
 	// TraceArchivalServiceAccountName is the name of the service account for the trace archival CronJob
 	TraceArchivalServiceAccountName = "mlflow-trace-archival-sa"
+	// ClientServiceAccountName is the name of the service account that owns the client token
+	ClientServiceAccountName = "mlflow-client-sa"
+	// ClientTokenSecretName is the name of the operator-managed client token secret
+	ClientTokenSecretName = "mlflow-client-token"
 	// TLSSecretName is the default name for the TLS secret used by the MLflow server
 	TLSSecretName = "mlflow-tls"
 	// StaticPrefix is the URL prefix for MLflow when deployed via the operator
@@ -55,4 +59,18 @@ const (
 	RoleBindingViewName = "odh-group-mlflow-view"
 	// RoleBindingEditName is the name of the edit RoleBinding in workspace namespaces
 	RoleBindingEditName = "odh-group-mlflow-edit"
+	// ArtifactStoreInsecureCondition is the warning condition set while S3 TLS verification is disabled
+	ArtifactStoreInsecureCondition = "ArtifactStoreInsecure"
+	// StorageSizeIgnoredCondition is the warning condition set while storage size or class is ignored for an existing claim
+	StorageSizeIgnoredCondition = "StorageSizeIgnored"
+	// EphemeralStorageCondition is the warning condition set while MLflow writes data to the container filesystem
+	EphemeralStorageCondition = "EphemeralStorage"
+	// SQLiteConcurrencyCondition is the warning condition set while several processes write one SQLite store
+	SQLiteConcurrencyCondition = "SQLiteConcurrency"
+	// SQLiteInProductionCondition is the warning condition set while a production install uses a SQLite store
+	SQLiteInProductionCondition = "SQLiteInProduction"
+	// PausedCondition is True while reconciliation is paused by the paused annotation
+	PausedCondition = "Paused"
+	// RenderOnlyCondition is True while the render-only annotation keeps the operator from applying resources
+	RenderOnlyCondition = "RenderOnly"
 )
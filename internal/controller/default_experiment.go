@@ -0,0 +1,54 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	_ "embed"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	defaultExperimentCommand         = `exec python3.12 -c "$DEFAULT_EXPERIMENT_PYTHON_SCRIPT"`
+	portableDefaultExperimentCommand = `exec "$(command -v python3.12 || command -v python3)" -c "$DEFAULT_EXPERIMENT_PYTHON_SCRIPT"`
+)
+
+//go:embed assets/mlflow_default_experiment.py
+var defaultExperimentPythonScript string
+
+// defaultExperimentCommandForImage picks the interpreter for the default
+// experiment init container the same way migrationJobCommandForImage does.
+func defaultExperimentCommandForImage(image string) string {
+	version := mlflowVersionFromImage(image)
+	if version != nil && version.LessThan(python3MigrationBaseline) {
+		return portableDefaultExperimentCommand
+	}
+	return defaultExperimentCommand
+}
+
+// defaultExperimentValues returns the defaultExperiment Helm values. The init
+// container is only rendered when name is non-empty.
+func defaultExperimentValues(mlflow *mlflowv1.MLflow, image string) map[string]interface{} {
+	if mlflow.Spec.DefaultExperiment == nil || *mlflow.Spec.DefaultExperiment == "" {
+		return map[string]interface{}{"name": ""}
+	}
+	return map[string]interface{}{
+		"name":    *mlflow.Spec.DefaultExperiment,
+		"command": defaultExperimentCommandForImage(image),
+		"script":  defaultExperimentPythonScript,
+	}
+}
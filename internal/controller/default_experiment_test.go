@@ -0,0 +1,115 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestDefaultExperimentCommandForImage(t *testing.T) {
+	tests := []struct {
+		name  string
+		image string
+		want  string
+	}{
+		{
+			name:  "current release uses python3.12",
+			image: "quay.io/opendatahub/mlflow:v3.10.1",
+			want:  defaultExperimentCommand,
+		},
+		{
+			name:  "older release probes for an interpreter",
+			image: "quay.io/opendatahub/mlflow:v3.4.0",
+			want:  portableDefaultExperimentCommand,
+		},
+		{
+			name:  "unversioned image uses python3.12",
+			image: "quay.io/opendatahub/mlflow@sha256:abc123",
+			want:  defaultExperimentCommand,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := defaultExperimentCommandForImage(tt.image); got != tt.want {
+				t.Errorf("defaultExperimentCommandForImage(%q) = %q, want %q", tt.image, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderChart_DefaultExperiment(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.InitContainers).To(gomega.BeEmpty())
+
+	objs, err = renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURIFrom: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"}, Key: "uri"},
+			Image:               &mlflowv1.ImageConfig{Image: ptr("quay.io/opendatahub/mlflow:v3.4.0")},
+			ServeArtifacts:      ptr(true),
+			DefaultExperiment:   ptr("team-experiments"),
+		},
+	}, "test-ns", RenderOptions{PlatformTrustedCABundleExists: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err = renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// The experiment is created after the CA bundle is combined, so database TLS can verify.
+	initContainers := deployment.Spec.Template.Spec.InitContainers
+	g.Expect(initContainers).To(gomega.HaveLen(2))
+	g.Expect(initContainers[0].Name).To(gomega.Equal("combine-ca-bundles"))
+	initContainer := initContainers[1]
+	g.Expect(initContainer.Name).To(gomega.Equal("create-default-experiment"))
+	g.Expect(initContainer.Image).To(gomega.Equal("quay.io/opendatahub/mlflow:v3.4.0"))
+	g.Expect(initContainer.Command).To(gomega.Equal([]string{"/bin/sh", "-ec"}))
+	g.Expect(initContainer.Args).To(gomega.Equal([]string{portableDefaultExperimentCommand}))
+
+	envByName := map[string]corev1.EnvVar{}
+	for _, env := range initContainer.Env {
+		envByName[env.Name] = env
+	}
+	g.Expect(envByName["MLFLOW_DEFAULT_EXPERIMENT_NAME"].Value).To(gomega.Equal("team-experiments"))
+	g.Expect(envByName["MLFLOW_DEFAULT_ARTIFACT_ROOT"].Value).To(gomega.Equal("mlflow-artifacts:/"))
+	g.Expect(envByName["DEFAULT_EXPERIMENT_PYTHON_SCRIPT"].Value).To(gomega.Equal(defaultExperimentPythonScript))
+	g.Expect(envByName["MLFLOW_BACKEND_STORE_URI"].ValueFrom.SecretKeyRef.Name).To(gomega.Equal("db-credentials"))
+	g.Expect(envByName).To(gomega.HaveKey("PGSSLROOTCERT"))
+	g.Expect(initContainer.VolumeMounts).To(gomega.ContainElement(gomega.HaveField("Name", "combined-ca-bundle")))
+
+	// Migrations bring the schema up to date first, so they never create the experiment.
+	job, err := buildMigrationJobFromDeployment(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+	}, deployment, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.InitContainers).To(gomega.HaveLen(1))
+	g.Expect(job.Spec.Template.Spec.InitContainers[0].Name).To(gomega.Equal("combine-ca-bundles"))
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// multipleDegradedCausesReason is the Degraded reason set when more than one
+// spec check fails at once.
+const multipleDegradedCausesReason = "MultipleIssues"
+
+// degradedCause is a problem found by a spec check before rendering, such as
+// a LimitRange conflict, that makes MLflow Degraded.
+type degradedCause struct {
+	reason  string
+	message string
+}
+
+// setSpecDegradedCondition sets Degraded=True once for all non-nil causes,
+// and reports whether it did. A single cause keeps its own reason; several
+// causes are reported together so none of them hides another.
+func setSpecDegradedCondition(mlflow *mlflowv1.MLflow, causes ...*degradedCause) bool {
+	var reasons, messages []string
+	for _, cause := range causes {
+		if cause != nil {
+			reasons = append(reasons, cause.reason)
+			messages = append(messages, cause.message)
+		}
+	}
+	if len(reasons) == 0 {
+		return false
+	}
+	reason := reasons[0]
+	if len(reasons) > 1 {
+		reason = multipleDegradedCausesReason
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               degradedConditionType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             reason,
+		Message:            strings.Join(messages, " "),
+	})
+	return true
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestSetSpecDegradedCondition(t *testing.T) {
+	missingAPI := &degradedCause{reason: missingDependencyReason, message: "Required API not installed."}
+	limitRange := &degradedCause{reason: limitRangeConflictReason, message: "Resources conflict with a LimitRange."}
+
+	tests := []struct {
+		name        string
+		causes      []*degradedCause
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name:   "no causes",
+			causes: []*degradedCause{nil, nil},
+		},
+		{
+			name:        "single cause keeps its reason",
+			causes:      []*degradedCause{nil, limitRange, nil},
+			wantReason:  limitRangeConflictReason,
+			wantMessage: "Resources conflict with a LimitRange.",
+		},
+		{
+			name:        "several causes are reported together",
+			causes:      []*degradedCause{missingAPI, limitRange},
+			wantReason:  multipleDegradedCausesReason,
+			wantMessage: "Required API not installed. Resources conflict with a LimitRange.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Generation: 5}}
+
+			g.Expect(setSpecDegradedCondition(mlflow, tt.causes...)).To(gomega.Equal(tt.wantReason != ""))
+			condition := meta.FindStatusCondition(mlflow.Status.Conditions, degradedConditionType)
+			if tt.wantReason == "" {
+				g.Expect(condition).To(gomega.BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(gomega.BeNil())
+			g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+			g.Expect(condition.Reason).To(gomega.Equal(tt.wantReason))
+			g.Expect(condition.Message).To(gomega.Equal(tt.wantMessage))
+			g.Expect(condition.ObservedGeneration).To(gomega.Equal(int64(5)))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// missingDependencyReason is the Degraded reason set while the spec enables a
+// feature whose API is not installed in the cluster.
+const missingDependencyReason = "MissingDependency"
+
+// missingDependencies lists the optional APIs that features enabled in the
+// spec need but that discovery did not find when the operator started. The
+// chart skips the matching objects, so without this check the feature would
+// silently do nothing.
+func (r *MLflowReconciler) missingDependencies(mlflow *mlflowv1.MLflow) []string {
+	var missing []string
+	if route := openShiftRoute(mlflow); route != nil && route.Enabled != nil && *route.Enabled && !r.RouteAvailable {
+		missing = append(missing, fmt.Sprintf("%s (route.openshift.io/v1) for spec.openShift.route", RouteCRDName))
+	}
+	if mlflow.Spec.Monitoring != nil && !r.ServiceMonitorAvailable {
+		missing = append(missing, fmt.Sprintf("%s (monitoring.coreos.com/v1) for spec.monitoring", ServiceMonitorCRDName))
+	}
+	return missing
+}
+
+func openShiftRoute(mlflow *mlflowv1.MLflow) *mlflowv1.RouteSpec {
+	if mlflow.Spec.OpenShift == nil {
+		return nil
+	}
+	return mlflow.Spec.OpenShift.Route
+}
+
+// missingDependencyCause returns the Degraded cause when the spec enables a
+// feature whose API is missing, or nil. Discovery runs once at startup, so
+// the operator must be restarted after the CRD is installed.
+func (r *MLflowReconciler) missingDependencyCause(mlflow *mlflowv1.MLflow) *degradedCause {
+	missing := r.missingDependencies(mlflow)
+	if len(missing) == 0 {
+		return nil
+	}
+	return &degradedCause{
+		reason: missingDependencyReason,
+		message: fmt.Sprintf("Required API not installed in the cluster: %s. "+
+			"The feature is skipped; install the CRD and restart the operator, or disable the feature.",
+			strings.Join(missing, "; ")),
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// reconcilerWithDiscovery builds a reconciler whose API availability comes
+// from discovery, the same way cmd/main.go sets it up at startup.
+func reconcilerWithDiscovery(t *testing.T, resources ...*metav1.APIResourceList) *MLflowReconciler {
+	t.Helper()
+	g := gomega.NewWithT(t)
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: resources}}
+	routeAvailable, err := IsRouteAvailable(discoveryClient)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	serviceMonitorAvailable, err := IsServiceMonitorAvailable(discoveryClient)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	return &MLflowReconciler{RouteAvailable: routeAvailable, ServiceMonitorAvailable: serviceMonitorAvailable}
+}
+
+func TestSetMissingDependencyCondition(t *testing.T) {
+	routeAPI := &metav1.APIResourceList{
+		GroupVersion: "route.openshift.io/v1",
+		APIResources: []metav1.APIResource{{Name: "routes", Kind: RouteCRDName}},
+	}
+	serviceMonitorAPI := &metav1.APIResourceList{
+		GroupVersion: "monitoring.coreos.com/v1",
+		APIResources: []metav1.APIResource{{Name: "servicemonitors", Kind: ServiceMonitorCRDName}},
+	}
+	routeAndMonitoring := mlflowv1.MLflowSpec{
+		OpenShift:  &mlflowv1.OpenShiftSpec{Route: &mlflowv1.RouteSpec{Enabled: ptr(true)}},
+		Monitoring: &mlflowv1.MonitoringSpec{},
+	}
+
+	tests := []struct {
+		name        string
+		resources   []*metav1.APIResourceList
+		spec        mlflowv1.MLflowSpec
+		wantMissing []string
+	}{
+		{
+			name:      "all APIs installed",
+			resources: []*metav1.APIResourceList{routeAPI, serviceMonitorAPI},
+			spec:      routeAndMonitoring,
+		},
+		{
+			name:      "missing ServiceMonitor CRD",
+			resources: []*metav1.APIResourceList{routeAPI},
+			spec:      routeAndMonitoring,
+			wantMissing: []string{
+				"ServiceMonitor (monitoring.coreos.com/v1) for spec.monitoring",
+			},
+		},
+		{
+			name: "missing Route and ServiceMonitor APIs",
+			spec: routeAndMonitoring,
+			wantMissing: []string{
+				"Route (route.openshift.io/v1) for spec.openShift.route",
+				"ServiceMonitor (monitoring.coreos.com/v1) for spec.monitoring",
+			},
+		},
+		{
+			name: "missing APIs for features that are not enabled",
+			spec: mlflowv1.MLflowSpec{
+				OpenShift: &mlflowv1.OpenShiftSpec{Route: &mlflowv1.RouteSpec{Enabled: ptr(false)}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			r := reconcilerWithDiscovery(t, tt.resources...)
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Generation: 4},
+				Spec:       tt.spec,
+			}
+
+			g.Expect(r.missingDependencies(mlflow)).To(gomega.Equal(tt.wantMissing))
+			g.Expect(setSpecDegradedCondition(mlflow, r.missingDependencyCause(mlflow))).To(gomega.Equal(len(tt.wantMissing) > 0))
+
+			condition := meta.FindStatusCondition(mlflow.Status.Conditions, degradedConditionType)
+			if len(tt.wantMissing) == 0 {
+				g.Expect(condition).To(gomega.BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(gomega.BeNil())
+			g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+			g.Expect(condition.Reason).To(gomega.Equal(missingDependencyReason))
+			g.Expect(condition.ObservedGeneration).To(gomega.Equal(int64(4)))
+			for _, missing := range tt.wantMissing {
+				g.Expect(condition.Message).To(gomega.ContainSubstring(missing))
+			}
+		})
+	}
+}
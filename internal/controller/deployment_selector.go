@@ -0,0 +1,262 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// degradedConditionType is set while the operator recreates the MLflow Deployment.
+	degradedConditionType = "Degraded"
+	// orphanedFromDeploymentAnnotation marks ReplicaSets orphaned from the named
+	// Deployment during a selector migration so they can be removed once the
+	// replacement Deployment is ready.
+	orphanedFromDeploymentAnnotation = "mlflow.opendatahub.io/orphaned-from-deployment"
+
+	deploymentSelectorChangedReason  = "DeploymentSelectorChanged"
+	deploymentSelectorMigratedReason = "DeploymentSelectorMigrated"
+	deploymentSelectorRequeueAfter   = 5 * time.Second
+)
+
+// reconcileDeploymentSelector recreates the MLflow Deployment when its existing
+// spec.selector differs from the rendered one, since the field is immutable and
+// Server-Side Apply would otherwise fail on every reconcile after an operator
+// upgrade that changes the label scheme.
+//
+// The Deployment is deleted with orphan propagation so its ReplicaSets and pods
+// keep serving. If the new selector matches the old pod labels, the replacement
+// Deployment adopts them; otherwise they are annotated and removed by
+// cleanupOrphanedReplicaSets once the replacement is ready. When the old and
+// new pods must not overlap, the orphaned ReplicaSets are scaled to zero
+// before the replacement is applied. The returned bool
+// reports whether the caller should stop and requeue.
+func (r *MLflowReconciler) reconcileDeploymentSelector(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	objects []*unstructured.Unstructured,
+) (ctrl.Result, bool, error) {
+	log := logf.FromContext(ctx)
+	deploymentName := ResourceName + getResourceSuffix(mlflow.Name)
+
+	desired, err := renderedDeployment(objects, deploymentName, namespace)
+	if err != nil {
+		return ctrl.Result{}, false, err
+	}
+
+	existing := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, existing); err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, false, err
+		}
+		// The old Deployment is gone. Stop its pods before the replacement is
+		// applied when they must not overlap with the new ones.
+		if isDeploymentSelectorMigrating(mlflow) && orphanedPodsMustStop(mlflow, desired) {
+			if err := r.scaleDownOrphanedReplicaSets(ctx, deploymentName, namespace); err != nil {
+				return ctrl.Result{}, false, err
+			}
+		}
+		return ctrl.Result{}, false, nil
+	}
+
+	if existing.DeletionTimestamp != nil {
+		// The orphan finalizer is still releasing the ReplicaSets.
+		setDeploymentSelectorDegraded(mlflow, fmt.Sprintf(
+			"Waiting for Deployment %s to be deleted before recreating it with the new selector", deploymentName))
+		if err := r.updateStatus(ctx, mlflow); err != nil {
+			return ctrl.Result{}, true, err
+		}
+		return ctrl.Result{RequeueAfter: deploymentSelectorRequeueAfter}, true, nil
+	}
+
+	if !deploymentSelectorChanged(existing, desired) {
+		return ctrl.Result{}, false, nil
+	}
+
+	log.Info("Deployment selector changed, recreating Deployment",
+		"name", deploymentName,
+		"namespace", namespace,
+		"currentSelector", metav1.FormatLabelSelector(existing.Spec.Selector),
+		"desiredSelector", metav1.FormatLabelSelector(desired.Spec.Selector))
+
+	if err := r.markOwnedReplicaSets(ctx, existing); err != nil {
+		return ctrl.Result{}, false, err
+	}
+	if err := r.Delete(ctx, existing, client.PropagationPolicy(metav1.DeletePropagationOrphan)); err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, false, fmt.Errorf("delete Deployment %s/%s: %w", namespace, deploymentName, err)
+	}
+
+	message := fmt.Sprintf(
+		"Recreating Deployment %s because its selector changed; existing pods keep serving until the new Deployment is ready",
+		deploymentName)
+	if orphanedPodsMustStop(mlflow, desired) {
+		message = fmt.Sprintf(
+			"Recreating Deployment %s because its selector changed; existing pods are stopped before the new Deployment starts",
+			deploymentName)
+	}
+	setDeploymentSelectorDegraded(mlflow, message)
+	if err := r.updateStatus(ctx, mlflow); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	return ctrl.Result{RequeueAfter: deploymentSelectorRequeueAfter}, true, nil
+}
+
+// deploymentSelectorChanged reports whether the rendered Deployment selector
+// differs from the one on the existing Deployment.
+func deploymentSelectorChanged(existing, desired *appsv1.Deployment) bool {
+	if desired.Spec.Selector == nil {
+		return false
+	}
+	return !equality.Semantic.DeepEqual(existing.Spec.Selector, desired.Spec.Selector)
+}
+
+// markOwnedReplicaSets annotates the ReplicaSets controlled by deployment so
+// they can still be found after the orphan delete removes their owner reference.
+func (r *MLflowReconciler) markOwnedReplicaSets(ctx context.Context, deployment *appsv1.Deployment) error {
+	replicaSets := &appsv1.ReplicaSetList{}
+	if err := r.List(ctx, replicaSets, client.InNamespace(deployment.Namespace)); err != nil {
+		return fmt.Errorf("list ReplicaSets in %s: %w", deployment.Namespace, err)
+	}
+	for i := range replicaSets.Items {
+		rs := &replicaSets.Items[i]
+		owner := metav1.GetControllerOf(rs)
+		if owner == nil || owner.UID != deployment.UID {
+			continue
+		}
+		patch := client.MergeFrom(rs.DeepCopy())
+		if rs.Annotations == nil {
+			rs.Annotations = map[string]string{}
+		}
+		rs.Annotations[orphanedFromDeploymentAnnotation] = deployment.Name
+		if err := r.Patch(ctx, rs, patch); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("annotate ReplicaSet %s/%s: %w", rs.Namespace, rs.Name, err)
+		}
+	}
+	return nil
+}
+
+// orphanedPodsMustStop reports whether the pods of the recreated Deployment
+// have to stop before the replacement starts: with the Recreate strategy the
+// new pods need the ReadWriteOnce volume the old ones hold, and a pending
+// migration must not run while the old pods still serve.
+func orphanedPodsMustStop(mlflow *mlflowv1.MLflow, desired *appsv1.Deployment) bool {
+	return desired.Spec.Strategy.Type == appsv1.RecreateDeploymentStrategyType ||
+		(migrationEnabled(mlflow) && migrationRequested(mlflow))
+}
+
+// scaleDownOrphanedReplicaSets scales the ReplicaSets orphaned from the named
+// Deployment to zero. They are deleted by cleanupOrphanedReplicaSets once the
+// replacement Deployment is ready.
+func (r *MLflowReconciler) scaleDownOrphanedReplicaSets(ctx context.Context, deploymentName, namespace string) error {
+	log := logf.FromContext(ctx)
+
+	replicaSets := &appsv1.ReplicaSetList{}
+	if err := r.List(ctx, replicaSets, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("list ReplicaSets in %s: %w", namespace, err)
+	}
+	for i := range replicaSets.Items {
+		rs := &replicaSets.Items[i]
+		if rs.Annotations[orphanedFromDeploymentAnnotation] != deploymentName || metav1.GetControllerOf(rs) != nil {
+			continue
+		}
+		if rs.Spec.Replicas != nil && *rs.Spec.Replicas == 0 {
+			continue
+		}
+		patch := client.MergeFrom(rs.DeepCopy())
+		zero := int32(0)
+		rs.Spec.Replicas = &zero
+		if err := r.Patch(ctx, rs, patch); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("scale down orphaned ReplicaSet %s/%s: %w", rs.Namespace, rs.Name, err)
+		}
+		log.Info("Scaled down ReplicaSet orphaned by Deployment selector migration", "name", rs.Name, "namespace", rs.Namespace)
+	}
+	return nil
+}
+
+// cleanupOrphanedReplicaSets deletes the ReplicaSets left behind by a selector
+// migration that the replacement Deployment did not adopt, and clears the
+// Degraded condition. Callers must only invoke it once the replacement
+// Deployment is ready.
+func (r *MLflowReconciler) cleanupOrphanedReplicaSets(ctx context.Context, mlflow *mlflowv1.MLflow, deployment *appsv1.Deployment) error {
+	log := logf.FromContext(ctx)
+
+	replicaSets := &appsv1.ReplicaSetList{}
+	if err := r.List(ctx, replicaSets, client.InNamespace(deployment.Namespace)); err != nil {
+		return fmt.Errorf("list ReplicaSets in %s: %w", deployment.Namespace, err)
+	}
+	for i := range replicaSets.Items {
+		rs := &replicaSets.Items[i]
+		if rs.Annotations[orphanedFromDeploymentAnnotation] != deployment.Name || metav1.GetControllerOf(rs) != nil {
+			continue
+		}
+		if err := r.Delete(ctx, rs, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("delete orphaned ReplicaSet %s/%s: %w", rs.Namespace, rs.Name, err)
+		}
+		log.Info("Deleted ReplicaSet orphaned by Deployment selector migration", "name", rs.Name, "namespace", rs.Namespace)
+	}
+
+	if condition := meta.FindStatusCondition(mlflow.Status.Conditions, degradedConditionType); condition != nil &&
+		condition.Reason == deploymentSelectorChangedReason {
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:               degradedConditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: mlflow.Generation,
+			Reason:             deploymentSelectorMigratedReason,
+			Message:            fmt.Sprintf("Deployment %s was recreated with the new selector", deployment.Name),
+		})
+	}
+	return nil
+}
+
+// isDeploymentSelectorMigrating reports whether a Deployment selector
+// migration is still waiting for its cleanup.
+func isDeploymentSelectorMigrating(mlflow *mlflowv1.MLflow) bool {
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, degradedConditionType)
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.Reason == deploymentSelectorChangedReason
+}
+
+func setDeploymentSelectorDegraded(mlflow *mlflowv1.MLflow, message string) {
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               degradedConditionType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             deploymentSelectorChangedReason,
+		Message:            message,
+	})
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// newSelectorTestReconciler renders the chart for a default MLflow and returns a
+// reconciler backed by a fake client seeded with the MLflow and objs.
+func newSelectorTestReconciler(t *testing.T, objs ...client.Object) (*MLflowReconciler, *mlflowv1.MLflow, []*unstructured.Unstructured) {
+	t.Helper()
+	scheme := newTestScheme(t)
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add scheme: %v", err)
+	}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Generation: 2},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+	rendered, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	if err != nil {
+		t.Fatalf("RenderChart() error = %v", err)
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&mlflowv1.MLflow{}).
+		WithObjects(append([]client.Object{mlflow.DeepCopy()}, objs...)...).
+		Build()
+	return &MLflowReconciler{Client: k8sClient, Scheme: scheme}, mlflow, rendered
+}
+
+func selectorTestDeployment(selector map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Namespace: "test-ns", UID: "old-deployment"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+		},
+	}
+}
+
+func selectorTestReplicaSet(name string, owner *appsv1.Deployment, annotations map[string]string) *appsv1.ReplicaSet {
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "test-ns",
+			Labels:      map[string]string{"app": "mlflow"},
+			Annotations: annotations,
+		},
+	}
+	if owner != nil {
+		rs.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       owner.Name,
+			UID:        owner.UID,
+			Controller: ptr(true),
+		}}
+	}
+	return rs
+}
+
+func TestReconcileDeploymentSelector_Unchanged(t *testing.T) {
+	g := gomega.NewWithT(t)
+	reconciler, mlflow, rendered := newSelectorTestReconciler(t,
+		selectorTestDeployment(map[string]string{"app": "mlflow"}))
+
+	_, handled, err := reconciler.reconcileDeploymentSelector(context.Background(), mlflow, "test-ns", rendered)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(handled).To(gomega.BeFalse())
+
+	g.Expect(reconciler.Get(context.Background(), types.NamespacedName{Name: "mlflow", Namespace: "test-ns"}, &appsv1.Deployment{})).To(gomega.Succeed())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, degradedConditionType)).To(gomega.BeNil())
+}
+
+func TestReconcileDeploymentSelector_MissingDeployment(t *testing.T) {
+	g := gomega.NewWithT(t)
+	reconciler, mlflow, rendered := newSelectorTestReconciler(t)
+
+	_, handled, err := reconciler.reconcileDeploymentSelector(context.Background(), mlflow, "test-ns", rendered)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(handled).To(gomega.BeFalse())
+}
+
+func TestReconcileDeploymentSelector_RecreatesOnChange(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	existing := selectorTestDeployment(map[string]string{"app": "mlflow", "legacy": "true"})
+	other := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test-ns", UID: "other-deployment"}}
+	reconciler, mlflow, rendered := newSelectorTestReconciler(t,
+		existing,
+		selectorTestReplicaSet("mlflow-old", existing, nil),
+		selectorTestReplicaSet("other-rs", other, nil),
+	)
+
+	result, handled, err := reconciler.reconcileDeploymentSelector(ctx, mlflow, "test-ns", rendered)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(handled).To(gomega.BeTrue())
+	g.Expect(result.RequeueAfter).To(gomega.Equal(deploymentSelectorRequeueAfter))
+
+	err = reconciler.Get(ctx, types.NamespacedName{Name: "mlflow", Namespace: "test-ns"}, &appsv1.Deployment{})
+	g.Expect(errors.IsNotFound(err)).To(gomega.BeTrue(), "old Deployment should be deleted, got %v", err)
+
+	// Only the ReplicaSet of the recreated Deployment is marked for cleanup.
+	rs := &appsv1.ReplicaSet{}
+	g.Expect(reconciler.Get(ctx, types.NamespacedName{Name: "mlflow-old", Namespace: "test-ns"}, rs)).To(gomega.Succeed())
+	g.Expect(rs.Annotations).To(gomega.HaveKeyWithValue(orphanedFromDeploymentAnnotation, "mlflow"))
+	g.Expect(reconciler.Get(ctx, types.NamespacedName{Name: "other-rs", Namespace: "test-ns"}, rs)).To(gomega.Succeed())
+	g.Expect(rs.Annotations).NotTo(gomega.HaveKey(orphanedFromDeploymentAnnotation))
+
+	for _, conditions := range [][]metav1.Condition{mlflow.Status.Conditions, persistedConditions(t, reconciler)} {
+		condition := meta.FindStatusCondition(conditions, degradedConditionType)
+		g.Expect(condition).NotTo(gomega.BeNil())
+		g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+		g.Expect(condition.Reason).To(gomega.Equal(deploymentSelectorChangedReason))
+	}
+}
+
+func TestReconcileDeploymentSelector_WaitsForDeletion(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	existing := selectorTestDeployment(map[string]string{"app": "mlflow"})
+	existing.Finalizers = []string{metav1.FinalizerOrphanDependents}
+	reconciler, mlflow, rendered := newSelectorTestReconciler(t, existing)
+	g.Expect(reconciler.Delete(ctx, existing.DeepCopy())).To(gomega.Succeed())
+
+	result, handled, err := reconciler.reconcileDeploymentSelector(ctx, mlflow, "test-ns", rendered)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(handled).To(gomega.BeTrue())
+	g.Expect(result.RequeueAfter).To(gomega.Equal(deploymentSelectorRequeueAfter))
+	g.Expect(meta.IsStatusConditionTrue(mlflow.Status.Conditions, degradedConditionType)).To(gomega.BeTrue())
+}
+
+func TestReconcileDeploymentSelector_ScalesDownOrphanedReplicaSets(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		storage      *corev1.PersistentVolumeClaimSpec
+		wantReplicas int32
+	}{
+		{
+			// The new pods need the ReadWriteOnce volume the old pods hold.
+			name: "ReadWriteOnce storage with the Recreate strategy",
+			storage: &corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			},
+			wantReplicas: 0,
+		},
+		{
+			name:         "remote stores with the RollingUpdate strategy",
+			wantReplicas: 1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			ctx := context.Background()
+			marked := map[string]string{orphanedFromDeploymentAnnotation: "mlflow"}
+			orphaned := selectorTestReplicaSet("mlflow-old", nil, marked)
+			orphaned.Spec.Replicas = ptr(int32(1))
+			reconciler, mlflow, _ := newSelectorTestReconciler(t, orphaned)
+			mlflow.Spec.Storage = tt.storage
+			// No migration is pending, so only the strategy decides.
+			mlflow.Status.Version = SupportedMLflowVersion
+			setDeploymentSelectorDegraded(mlflow, "recreating")
+			rendered, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			_, handled, err := reconciler.reconcileDeploymentSelector(ctx, mlflow, "test-ns", rendered)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(handled).To(gomega.BeFalse())
+
+			rs := &appsv1.ReplicaSet{}
+			g.Expect(reconciler.Get(ctx, types.NamespacedName{Name: "mlflow-old", Namespace: "test-ns"}, rs)).To(gomega.Succeed())
+			g.Expect(rs.Spec.Replicas).To(gomega.HaveValue(gomega.Equal(tt.wantReplicas)))
+		})
+	}
+}
+
+func TestCleanupOrphanedReplicaSets(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	deployment := selectorTestDeployment(map[string]string{"app": "mlflow"})
+	deployment.UID = "new-deployment"
+	marked := map[string]string{orphanedFromDeploymentAnnotation: "mlflow"}
+	reconciler, mlflow, _ := newSelectorTestReconciler(t,
+		deployment,
+		selectorTestReplicaSet("orphaned", nil, marked),
+		selectorTestReplicaSet("adopted", deployment, marked),
+		selectorTestReplicaSet("unrelated", nil, nil),
+	)
+	setDeploymentSelectorDegraded(mlflow, "recreating")
+
+	g.Expect(reconciler.cleanupOrphanedReplicaSets(ctx, mlflow, deployment)).To(gomega.Succeed())
+
+	err := reconciler.Get(ctx, types.NamespacedName{Name: "orphaned", Namespace: "test-ns"}, &appsv1.ReplicaSet{})
+	g.Expect(errors.IsNotFound(err)).To(gomega.BeTrue(), "orphaned ReplicaSet should be deleted, got %v", err)
+	g.Expect(reconciler.Get(ctx, types.NamespacedName{Name: "adopted", Namespace: "test-ns"}, &appsv1.ReplicaSet{})).To(gomega.Succeed())
+	g.Expect(reconciler.Get(ctx, types.NamespacedName{Name: "unrelated", Namespace: "test-ns"}, &appsv1.ReplicaSet{})).To(gomega.Succeed())
+
+	condition := meta.FindStatusCondition(mlflow.Status.Conditions, degradedConditionType)
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(gomega.Equal(deploymentSelectorMigratedReason))
+}
+
+func TestCleanupOrphanedReplicaSets_LeavesUnrelatedDegradedCondition(t *testing.T) {
+	g := gomega.NewWithT(t)
+	deployment := selectorTestDeployment(map[string]string{"app": "mlflow"})
+	reconciler, mlflow, _ := newSelectorTestReconciler(t, deployment)
+
+	g.Expect(reconciler.cleanupOrphanedReplicaSets(context.Background(), mlflow, deployment)).To(gomega.Succeed())
+	g.Expect(meta.FindStatusCondition(mlflow.Status.Conditions, degradedConditionType)).To(gomega.BeNil())
+}
+
+func persistedConditions(t *testing.T, reconciler *MLflowReconciler) []metav1.Condition {
+	t.Helper()
+	latest := &mlflowv1.MLflow{}
+	if err := reconciler.Get(context.Background(), types.NamespacedName{Name: "mlflow"}, latest); err != nil {
+		t.Fatalf("get MLflow: %v", err)
+	}
+	return latest.Status.Conditions
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	effectiveConfigMapName = "mlflow-effective-config"
+	redactedValue          = "REDACTED"
+)
+
+// effectiveConfigURIKeys are the mlflow values that hold store URIs. Each may
+// instead be read from a Secret through the matching <key>From value.
+var effectiveConfigURIKeys = []string{
+	"backendStoreUri",
+	"readReplicaBackendStoreUri",
+	"registryStoreUri",
+	"artifactsDestination",
+	"defaultArtifactRoot",
+}
+
+// effectiveConfigSettingKeys are the mlflow values copied into the effective
+// configuration unchanged.
+var effectiveConfigSettingKeys = []string{
+	"serveArtifacts",
+	"workers",
+	"port",
+	"staticPrefix",
+	"allowedHosts",
+	"corsAllowedOrigins",
+	"workspaceStoreUri",
+	"workspaceLabelSelector",
+	"maxRequestHeaderBytes",
+	"timeoutKeepAlive",
+	"timeoutGracefulShutdown",
+	"accessLog",
+}
+
+func effectiveConfigEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.EffectiveConfig != nil && mlflow.Spec.EffectiveConfig.Enabled != nil && *mlflow.Spec.EffectiveConfig.Enabled
+}
+
+// effectiveConfigValues returns the effectiveConfig Helm values. The settings
+// are taken from the computed mlflow values, so they match what the chart
+// passes to the server. Store URIs have their credentials redacted, values
+// read from Secrets only name the Secret and key, and extra arguments that
+// carry a URI are redacted the same way.
+func effectiveConfigValues(mlflow *mlflowv1.MLflow, mlflowConfig map[string]interface{}) map[string]interface{} {
+	if !effectiveConfigEnabled(mlflow) {
+		return map[string]interface{}{"enabled": false}
+	}
+
+	settings := map[string]interface{}{}
+	for _, key := range effectiveConfigURIKeys {
+		if key == "artifactsDestination" && mlflowConfig["serveArtifacts"] != true {
+			continue
+		}
+		if ref, ok := mlflowConfig[key+"From"].(map[string]interface{}); ok {
+			settings[key] = secretSourcedValue(ref)
+		} else if uri, _ := mlflowConfig[key].(string); uri != "" {
+			settings[key] = redactURI(uri)
+		}
+	}
+	if ref, ok := mlflowConfig["backendStorePasswordFrom"].(map[string]interface{}); ok {
+		settings["backendStorePassword"] = secretSourcedValue(ref)
+	}
+	for _, key := range effectiveConfigSettingKeys {
+		if value, ok := mlflowConfig[key]; ok && value != "" {
+			settings[key] = value
+		}
+	}
+	if extraArgs, ok := mlflowConfig["extraArgs"].([]string); ok {
+		redacted := make([]string, 0, len(extraArgs))
+		for _, arg := range extraArgs {
+			redacted = append(redacted, redactArg(arg))
+		}
+		settings["extraArgs"] = redacted
+	}
+
+	return map[string]interface{}{
+		"enabled":  true,
+		"settings": settings,
+	}
+}
+
+// secretSourcedValue describes a value read from a Secret without exposing it.
+func secretSourcedValue(ref map[string]interface{}) string {
+	secretKeyRef, _ := ref["secretKeyRef"].(map[string]interface{})
+	return fmt.Sprintf("from Secret %v, key %v", secretKeyRef["name"], secretKeyRef["key"])
+}
+
+// redactURI replaces the password in a URI's user info and any password query
+// parameter. Values that do not parse as a URI with a scheme are returned
+// unchanged.
+func redactURI(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" {
+		return raw
+	}
+	if _, hasPassword := u.User.Password(); hasPassword {
+		u.User = url.UserPassword(u.User.Username(), redactedValue)
+	}
+	if query := u.Query(); query.Has("password") {
+		query.Set("password", redactedValue)
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
+
+// redactArg redacts a URI passed as an extra argument, either on its own or as
+// the value of a --flag=value argument.
+func redactArg(arg string) string {
+	if flag, value, ok := strings.Cut(arg, "="); ok && strings.HasPrefix(flag, "-") {
+		return flag + "=" + redactURI(value)
+	}
+	return redactURI(arg)
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// s3EndpointURLEnv points MLflow's S3 client at a non-AWS endpoint such as MinIO.
+const s3EndpointURLEnv = "MLFLOW_S3_ENDPOINT_URL"
+
+// s3EndpointURL returns the S3 endpoint the server uses: a literal
+// MLFLOW_S3_ENDPOINT_URL from spec.env, which takes precedence, otherwise
+// spec.artifactStore.s3.endpointURL. It returns "" when the endpoint is unset
+// or read from a Secret or ConfigMap.
+func s3EndpointURL(mlflow *mlflowv1.MLflow) string {
+	for _, env := range mlflow.Spec.Env {
+		if env.Name == s3EndpointURLEnv {
+			if env.ValueFrom != nil {
+				return ""
+			}
+			return env.Value
+		}
+	}
+	if store := mlflow.Spec.ArtifactStore; store != nil && store.S3 != nil && store.S3.EndpointURL != nil {
+		return *store.S3.EndpointURL
+	}
+	return ""
+}
+
+// defaultEgressPorts maps store URI schemes, without a "+driver" suffix, to
+// the port used when the URI omits one.
+var defaultEgressPorts = map[string]int32{
+	"postgresql": 5432,
+	"postgres":   5432,
+	"mysql":      3306,
+	"mssql":      1433,
+	"http":       80,
+	"https":      443,
+	"hdfs":       8020,
+}
+
+// objectStoreSchemes are artifact URI schemes whose host is a bucket or
+// container name rather than a network address.
+var objectStoreSchemes = map[string]bool{
+	"s3":    true,
+	"gs":    true,
+	"wasbs": true,
+	"abfss": true,
+}
+
+// egressEndpoint is a store host and TCP port the MLflow server connects to.
+// An empty host matches any destination.
+type egressEndpoint struct {
+	host string
+	port int32
+}
+
+// restrictEgress reports whether spec.networkPolicy.restrictEgress is set.
+func restrictEgress(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.NetworkPolicy != nil && mlflow.Spec.NetworkPolicy.RestrictEgress != nil &&
+		*mlflow.Spec.NetworkPolicy.RestrictEgress
+}
+
+// restrictedEgressRules returns the egress rules used when restrictEgress is
+// set: DNS, the Kubernetes API server, and the derived store endpoints.
+func restrictedEgressRules(mlflow *mlflowv1.MLflow) []networkingv1.NetworkPolicyEgressRule {
+	endpoints := append(databaseEgressEndpoints(mlflow), artifactEgressEndpoints(mlflow)...)
+	rules := []networkingv1.NetworkPolicyEgressRule{dnsEgressRule(), apiServerEgressRule()}
+	return append(rules, endpointEgressRules(endpoints)...)
+}
+
+// databaseEgressEndpoints returns the endpoints of the literal backend,
+// read-replica and registry store URIs. URIs from Secrets are skipped.
+func databaseEgressEndpoints(mlflow *mlflowv1.MLflow) []egressEndpoint {
+	var endpoints []egressEndpoint
+	if endpoint, ok := backendStoreEndpoint(mlflow); ok {
+		endpoints = append(endpoints, endpoint)
+	}
+	for _, uri := range []*string{mlflow.Spec.ReadReplicaBackendStoreURI, mlflow.Spec.RegistryStoreURI} {
+		if uri == nil {
+			continue
+		}
+		if endpoint, ok := egressEndpointFromURI(*uri, ""); ok {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// backendStoreEndpoint returns the database endpoint of the literal backend
+// store URI or database.connection. It reports false for SQLite and for URIs
+// read from a Secret.
+func backendStoreEndpoint(mlflow *mlflowv1.MLflow) (egressEndpoint, bool) {
+	switch {
+	case mlflow.Spec.BackendStoreURI != nil:
+		return egressEndpointFromURI(*mlflow.Spec.BackendStoreURI, "")
+	case mlflow.Spec.Database != nil && mlflow.Spec.Database.Connection != nil:
+		return egressEndpointFromURI(databaseConnectionURI(mlflow.Spec.Database.Connection), "")
+	}
+	return egressEndpoint{}, false
+}
+
+// artifactEgressEndpoints returns the endpoints of the literal artifact store
+// URIs the server proxies when serveArtifacts is enabled.
+func artifactEgressEndpoints(mlflow *mlflowv1.MLflow) []egressEndpoint {
+	if mlflow.Spec.ServeArtifacts == nil || !*mlflow.Spec.ServeArtifacts {
+		return nil
+	}
+	s3Endpoint := s3EndpointURL(mlflow)
+
+	var endpoints []egressEndpoint
+	for _, uri := range []*string{mlflow.Spec.ArtifactsDestination, mlflow.Spec.DefaultArtifactRoot} {
+		if uri == nil {
+			continue
+		}
+		if endpoint, ok := egressEndpointFromURI(*uri, s3Endpoint); ok {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// egressEndpointFromURI derives the endpoint a store URI connects to. Object
+// store URIs resolve to HTTPS on any host, or to s3Endpoint for s3:// when it
+// is set. It reports false for local and unparsable URIs.
+func egressEndpointFromURI(raw, s3Endpoint string) (egressEndpoint, bool) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return egressEndpoint{}, false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if objectStoreSchemes[scheme] {
+		if scheme == "s3" && s3Endpoint != "" {
+			return egressEndpointFromURI(s3Endpoint, "")
+		}
+		return egressEndpoint{port: 443}, true
+	}
+
+	defaultPort, ok := defaultEgressPorts[strings.SplitN(scheme, "+", 2)[0]]
+	if !ok || u.Hostname() == "" {
+		return egressEndpoint{}, false
+	}
+	endpoint := egressEndpoint{host: u.Hostname(), port: defaultPort}
+	if u.Port() != "" {
+		port, err := strconv.ParseInt(u.Port(), 10, 32)
+		if err != nil {
+			return egressEndpoint{}, false
+		}
+		endpoint.port = int32(port)
+	}
+	return endpoint, true
+}
+
+// endpointEgressRules returns one rule per distinct endpoint, sorted for a
+// stable rendering. Hosts that are IP addresses are matched with an ipBlock;
+// other hosts cannot be matched by a NetworkPolicy and only restrict the port.
+func endpointEgressRules(endpoints []egressEndpoint) []networkingv1.NetworkPolicyEgressRule {
+	seen := map[egressEndpoint]bool{}
+	unique := make([]egressEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if ip := net.ParseIP(endpoint.host); ip == nil {
+			endpoint.host = ""
+		}
+		if !seen[endpoint] {
+			seen[endpoint] = true
+			unique = append(unique, endpoint)
+		}
+	}
+	sort.Slice(unique, func(i, j int) bool {
+		if unique[i].port != unique[j].port {
+			return unique[i].port < unique[j].port
+		}
+		return unique[i].host < unique[j].host
+	})
+
+	tcp := corev1.ProtocolTCP
+	rules := make([]networkingv1.NetworkPolicyEgressRule, 0, len(unique))
+	for _, endpoint := range unique {
+		port := intstr.FromInt32(endpoint.port)
+		rule := networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: &port}},
+		}
+		if endpoint.host != "" {
+			cidr := endpoint.host + "/32"
+			if strings.Contains(endpoint.host, ":") {
+				cidr = endpoint.host + "/128"
+			}
+			rule.To = []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: cidr}}}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// dnsEgressRule allows DNS resolution. Port 53 is the Service port and 5353
+// the CoreDNS target port on OpenShift, where OVN-Kubernetes evaluates egress
+// rules after DNAT.
+func dnsEgressRule() networkingv1.NetworkPolicyEgressRule {
+	udp := corev1.ProtocolUDP
+	tcp := corev1.ProtocolTCP
+	port53 := intstr.FromInt32(53)
+	port5353 := intstr.FromInt32(5353)
+	return networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: &udp, Port: &port53},
+			{Protocol: &tcp, Port: &port53},
+			{Protocol: &udp, Port: &port5353},
+			{Protocol: &tcp, Port: &port5353},
+		},
+	}
+}
+
+// apiServerEgressRule allows the Kubernetes API server, which the
+// kubernetes-auth app calls to authorize every request. Its address is not
+// known to the operator, so only the ports are restricted.
+func apiServerEgressRule() networkingv1.NetworkPolicyEgressRule {
+	tcp := corev1.ProtocolTCP
+	port443 := intstr.FromInt32(443)
+	port6443 := intstr.FromInt32(6443)
+	return networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: &tcp, Port: &port443},
+			{Protocol: &tcp, Port: &port6443},
+		},
+	}
+}
@@ -20,9 +20,16 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net"
 	"net/url"
+	"os"
 	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
@@ -34,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -42,23 +50,56 @@ import (
 )
 
 const (
-	defaultStorageSize     = "2Gi"
-	defaultBackendStoreURI = "sqlite:////mlflow/mlflow.db"
-	defaultArtifactsDest   = "file:///mlflow/artifacts"
-	uvicornSSLCiphersEnv   = "UVICORN_SSL_CIPHERS"
-	uvicornSystemCiphers   = "PROFILE=SYSTEM"
+	defaultStorageSize                = "2Gi"
+	defaultTmpVolumeSizeLimit         = "128Mi"
+	defaultLivenessProbePath          = "/health"
+	defaultShutdownDelay              = int32(5)
+	remoteBackendReadinessDelay       = int32(15)
+	databaseReadinessTimeout          = int32(5)
+	maxDerivedWorkers                 = 8
+	defaultBackendStoreURI            = "sqlite:////mlflow/mlflow.db"
+	defaultDatabaseDriver             = "postgresql"
+	defaultDatabasePort               = int32(5432)
+	defaultRouteTLSTermination        = "reencrypt"
+	passthroughRouteTLSTermination    = "passthrough"
+	ingressBackendProtocolAnnotation  = "nginx.ingress.kubernetes.io/backend-protocol"
+	ingressProxyBufferingAnnotation   = "nginx.ingress.kubernetes.io/proxy-buffering"
+	ingressRequestBufferingAnnotation = "nginx.ingress.kubernetes.io/proxy-request-buffering"
+	defaultServicePortName            = "https"
+	serviceAccountTokenVolume         = "serviceaccount-token"
+	serviceAccountTokenMountPath      = "/var/run/secrets/kubernetes.io/serviceaccount"
+	defaultTargetCPUUtilization       = int32(80)
+	defaultArtifactsDest              = "file:///mlflow/artifacts"
+	artifactsSubPathMount             = "/mlflow-artifacts"
+	uvicornSSLCiphersEnv              = "UVICORN_SSL_CIPHERS"
+	uvicornSystemCiphers              = "PROFILE=SYSTEM"
 )
 
 var helmLog = logf.Log.WithName("helm")
 
+// reservedPodLabels are pod template labels the chart sets itself. "app" is
+// also the Deployment selector.
+var reservedPodLabels = map[string]bool{
+	"app":                        true,
+	"app.kubernetes.io/instance": true,
+	"component":                  true,
+}
+
 // CA bundle mount paths - used for mounting platform and custom CA ConfigMaps
+// and the artifact store CA Secret
 const (
-	systemCAPath    = "/etc/pki/tls/certs/ca-bundle.crt"
-	caPlatformMount = "/etc/pki/tls/certs/platform"
-	caCustomMount   = "/etc/pki/tls/certs/custom"
+	systemCAPath         = "/etc/pki/tls/certs/ca-bundle.crt"
+	caPlatformMount      = "/etc/pki/tls/certs/platform"
+	caCustomMount        = "/etc/pki/tls/certs/custom"
+	caArtifactStoreMount = "/etc/pki/tls/certs/artifact-store"
 
 	serviceCABundleConfigMapName = "openshift-service-ca.crt"
 	serviceCABundleConfigMapKey  = "service-ca.crt"
+
+	// certManagerCAKey is the Secret key cert-manager writes the issuing CA to.
+	certManagerCAKey = "ca.crt"
+	// certManagerCAFile is where clients of the MLflow server mount certManagerCAKey.
+	certManagerCAFile = "/etc/pki/tls/certs/mlflow-server/ca.crt"
 )
 
 // getResourceSuffix returns the suffix used by most per-instance MLflow resources.
@@ -72,6 +113,36 @@ func getResourceSuffix(mlflowName string) string {
 	return "-" + mlflowName
 }
 
+// secretKeyRefValues converts a SecretKeySelector into the valueFrom map the
+// chart renders for a store URI.
+func secretKeyRefValues(selector *corev1.SecretKeySelector) map[string]interface{} {
+	secretKeyRef := map[string]interface{}{
+		"name": selector.Name,
+		"key":  selector.Key,
+	}
+	if selector.Optional != nil {
+		secretKeyRef["optional"] = *selector.Optional
+	}
+	return map[string]interface{}{"secretKeyRef": secretKeyRef}
+}
+
+// mlflowBasePath returns the URL path the MLflow server is served under.
+func mlflowBasePath(mlflow *mlflowv1.MLflow) string {
+	if mlflow.Spec.BasePath != nil && *mlflow.Spec.BasePath != "" {
+		return *mlflow.Spec.BasePath
+	}
+	return StaticPrefix
+}
+
+// publicPathPrefix returns the path prefix MLflow is exposed under on the
+// gateway. It is spec.basePath when set, otherwise "/mlflow{{ suffix }}".
+func publicPathPrefix(mlflow *mlflowv1.MLflow) string {
+	if mlflow.Spec.BasePath != nil && *mlflow.Spec.BasePath != "" {
+		return *mlflow.Spec.BasePath
+	}
+	return "/" + ResourceName + getResourceSuffix(mlflow.Name)
+}
+
 // buildCORSAllowedOrigins returns a comma-separated list of allowed CORS origins
 // combining safe defaults with any user-specified extra origins from the CR spec.
 func buildCORSAllowedOrigins(mlflow *mlflowv1.MLflow, namespace string, cfg *config.OperatorConfig) string {
@@ -104,9 +175,85 @@ func buildCORSAllowedOrigins(mlflow *mlflowv1.MLflow, namespace string, cfg *con
 	return strings.Join(corsOrigins, ",")
 }
 
+// podIPHostReference is expanded by the kubelet from the POD_IP env var, so
+// probes and metrics scrapes that address the pod IP pass host validation.
+const podIPHostReference = "$(POD_IP)"
+
+// buildAllowedHosts returns the Host header patterns passed to --allowed-hosts.
+// spec.allowedHosts replaces the Service, gateway, Route, Ingress and pod DNS names and the
+// observed hosts; spec.extraAllowedHosts is added either way. Loopback and the pod IP are always
+// kept for in-pod and kubelet health checks.
+func buildAllowedHosts(mlflow *mlflowv1.MLflow, namespace string, cfg *config.OperatorConfig, observedHosts []string) []string {
+	var hosts []string
+	if len(mlflow.Spec.AllowedHosts) > 0 {
+		hosts = append(hosts, mlflow.Spec.AllowedHosts...)
+	} else {
+		serviceName := ResourceName + getResourceSuffix(mlflow.Name)
+		names := []string{
+			serviceName,
+			serviceName + "." + namespace,
+			serviceName + "." + namespace + ".svc",
+			serviceName + "." + namespace + ".svc.cluster.local",
+		}
+		if cfg.MLflowURL != "" {
+			if u, err := url.Parse(cfg.MLflowURL); err == nil && u.Hostname() != "" {
+				names = append(names, u.Hostname())
+			}
+		}
+		if openShift := mlflow.Spec.OpenShift; openShift != nil && openShift.Route != nil {
+			if route := openShift.Route; route.Enabled != nil && *route.Enabled && route.Host != nil {
+				names = append(names, *route.Host)
+			}
+		}
+		if ingress := mlflow.Spec.Ingress; ingress != nil && ingress.Enabled != nil && *ingress.Enabled && ingress.Host != nil {
+			names = append(names, *ingress.Host)
+		}
+		if service := mlflow.Spec.Service; service != nil && service.LoadBalancerIP != nil {
+			names = append(names, hostHeaderName(*service.LoadBalancerIP))
+		}
+		for _, host := range observedHosts {
+			if !slices.Contains(names, hostHeaderName(host)) {
+				names = append(names, hostHeaderName(host))
+			}
+		}
+		if mlflow.Spec.Hostname != nil && mlflow.Spec.Subdomain != nil {
+			podName := *mlflow.Spec.Hostname + "." + *mlflow.Spec.Subdomain
+			names = append(names,
+				podName+"."+namespace+".svc",
+				podName+"."+namespace+".svc.cluster.local",
+			)
+		}
+		for _, name := range names {
+			hosts = append(hosts, name, name+":*")
+		}
+	}
+
+	hosts = append(hosts, mlflow.Spec.ExtraAllowedHosts...)
+
+	for _, name := range []string{"localhost", "127.0.0.1", podIPHostReference} {
+		hosts = append(hosts, name, name+":*")
+	}
+	return hosts
+}
+
+// hostHeaderName returns host as it appears in a Host header. IPv6 literals
+// are bracketed.
+func hostHeaderName(host string) string {
+	if strings.Contains(host, ":") {
+		return "[" + host + "]"
+	}
+	return host
+}
+
 // HelmRenderer handles rendering of Helm charts
 type HelmRenderer struct {
 	chartPath string
+
+	// chartMu guards chart, which is parsed on first render and reused by
+	// later renders. The engine only reads the chart, so concurrent renders
+	// can share it.
+	chartMu sync.Mutex
+	chart   *chart.Chart
 }
 
 // RenderOptions contains additional context needed for rendering
@@ -119,6 +266,20 @@ type RenderOptions struct {
 	// ServiceMonitorAvailable indicates if the ServiceMonitor CRD (monitoring.coreos.com/v1) is available.
 	// When false, metrics.enabled is set to false to prevent rendering the ServiceMonitor manifest.
 	ServiceMonitorAvailable bool
+	// RouteAvailable indicates if the OpenShift Route API (route.openshift.io/v1) is available.
+	// When false, spec.openShift.route is ignored so no Route manifest is rendered.
+	RouteAvailable bool
+	// ContainerResourceBounds holds the container min and max set by LimitRanges in the target namespace.
+	// When set and spec.resources is unset, the default MLflow container resources are fitted into it.
+	ContainerResourceBounds *ContainerResourceBounds
+	// ObservedHosts are the hostnames and addresses the cluster assigned to the
+	// Route, Ingress and LoadBalancer Service, read from their status. They are
+	// added to the default allowed hosts.
+	ObservedHosts []string
+	// ReferencedDataHash is a hash of the data of the Secrets and ConfigMaps the
+	// spec references. It is set as a pod annotation so the pods roll when that
+	// data changes. Empty when the spec references none.
+	ReferencedDataHash string
 }
 
 // NewHelmRenderer creates a new HelmRenderer
@@ -128,17 +289,106 @@ func NewHelmRenderer(chartPath string) *HelmRenderer {
 	}
 }
 
-// RenderChart renders the Helm chart with the given values.
+// Validate checks that the chart path exists and points at a Helm chart
+// directory or archive, so a misconfigured path is reported clearly instead of
+// failing later inside the chart loader.
+func (h *HelmRenderer) Validate() error {
+	if h.chartPath == "" {
+		return fmt.Errorf("chart path is empty")
+	}
+	info, err := os.Stat(h.chartPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("chart path %q does not exist", h.chartPath)
+		}
+		return fmt.Errorf("failed to access chart path %q: %w", h.chartPath, err)
+	}
+	if !info.IsDir() {
+		// Packaged charts are validated by the loader when they are read.
+		return nil
+	}
+	if ok, err := chartutil.IsChartDir(h.chartPath); !ok {
+		return fmt.Errorf("chart path %q is not a valid Helm chart: %w", h.chartPath, err)
+	}
+	return nil
+}
+
+// loadChart returns the parsed chart, reading it from disk on first use.
+func (h *HelmRenderer) loadChart() (*chart.Chart, error) {
+	h.chartMu.Lock()
+	defer h.chartMu.Unlock()
+	if h.chart == nil {
+		loadedChart, err := h.readChart()
+		if err != nil {
+			return nil, err
+		}
+		h.chart = loadedChart
+	}
+	return h.chart, nil
+}
+
+// Reload re-reads the chart from disk and replaces the cached copy, so later
+// renders pick up changes to the chart files. The cached chart is kept if
+// the new one cannot be loaded.
+func (h *HelmRenderer) Reload() error {
+	h.chartMu.Lock()
+	defer h.chartMu.Unlock()
+	loadedChart, err := h.readChart()
+	if err != nil {
+		return err
+	}
+	h.chart = loadedChart
+	return nil
+}
+
+// ChartVersion returns the version from the chart's Chart.yaml.
+func (h *HelmRenderer) ChartVersion() (string, error) {
+	loadedChart, err := h.loadChart()
+	if err != nil {
+		return "", err
+	}
+	return loadedChart.Metadata.Version, nil
+}
+
+func (h *HelmRenderer) readChart() (*chart.Chart, error) {
+	if err := h.Validate(); err != nil {
+		return nil, err
+	}
+	loadedChart, err := loader.Load(h.chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chart: %w", err)
+	}
+	return loadedChart, nil
+}
+
+// RenderChart renders the Helm chart with the given values and records the
+// render duration and errors in the operator metrics.
 func (h *HelmRenderer) RenderChart(
 	mlflow *mlflowv1.MLflow,
 	namespace string,
 	opts RenderOptions,
 	cfg *config.OperatorConfig,
 ) ([]*unstructured.Unstructured, error) {
-	// Load the Helm chart
-	loadedChart, err := loader.Load(h.chartPath)
+	start := time.Now()
+	objects, err := h.renderChart(mlflow, namespace, opts, cfg)
+	result := metricsResultSuccess
 	if err != nil {
-		return nil, fmt.Errorf("failed to load chart: %w", err)
+		result = metricsResultError
+		renderErrorsTotal.Inc()
+	}
+	renderDurationSeconds.WithLabelValues(result).Observe(time.Since(start).Seconds())
+	return objects, err
+}
+
+func (h *HelmRenderer) renderChart(
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	opts RenderOptions,
+	cfg *config.OperatorConfig,
+) ([]*unstructured.Unstructured, error) {
+	loadedChart, err := h.loadChart()
+	if err != nil {
+		return nil, err
 	}
 
 	values, err := h.mlflowToHelmValues(mlflow, namespace, opts, cfg)
@@ -167,6 +417,601 @@ func isTraceArchivalEnabled(mlflow *mlflowv1.MLflow) bool {
 	return mlflow.Spec.TraceArchival != nil && mlflow.Spec.TraceArchival.Enabled
 }
 
+// databaseConnectionURI assembles the backend store URI from database
+// connection fields. net/url escapes the user name and database so characters
+// such as '@' or ':' cannot change how the URI parses. The password is
+// deliberately left out; see databasePasswordFrom.
+func databaseConnectionURI(conn *mlflowv1.DatabaseConnectionSpec) string {
+	driver := conn.Driver
+	if driver == "" {
+		driver = defaultDatabaseDriver
+	}
+	port := defaultDatabasePort
+	if conn.Port != nil {
+		port = *conn.Port
+	}
+	u := url.URL{
+		Scheme: driver,
+		User:   url.User(conn.User),
+		Host:   net.JoinHostPort(conn.Host, fmt.Sprintf("%d", port)),
+		Path:   "/" + conn.Database,
+	}
+	return u.String()
+}
+
+// databasePasswordFrom returns the env valueFrom for PGPASSWORD when the
+// backend store is configured through database.connection with a password
+// Secret. libpq reads PGPASSWORD whenever the URI carries no password.
+func databasePasswordFrom(mlflow *mlflowv1.MLflow) map[string]interface{} {
+	if mlflow.Spec.Database == nil || mlflow.Spec.Database.Connection == nil {
+		return nil
+	}
+	ref := mlflow.Spec.Database.Connection.PasswordSecret
+	if ref == nil {
+		return nil
+	}
+	return secretKeyRefValues(ref)
+}
+
+// databasePoolEnv returns the MLflow SQLAlchemy pool env vars for the pool
+// settings of spec.database.
+func databasePoolEnv(database *mlflowv1.DatabaseSpec) []corev1.EnvVar {
+	if database == nil {
+		return nil
+	}
+	var env []corev1.EnvVar
+	for _, setting := range []struct {
+		name  string
+		value *int32
+	}{
+		{"MLFLOW_SQLALCHEMYSTORE_POOL_SIZE", database.PoolSize},
+		{"MLFLOW_SQLALCHEMYSTORE_MAX_OVERFLOW", database.MaxOverflow},
+		{"MLFLOW_SQLALCHEMYSTORE_POOL_RECYCLE", database.PoolRecycleSeconds},
+	} {
+		if setting.value != nil {
+			env = append(env, corev1.EnvVar{Name: setting.name, Value: strconv.Itoa(int(*setting.value))})
+		}
+	}
+	return env
+}
+
+// usesRemoteBackendStore reports whether the backend store is a database
+// server rather than a local SQLite file. A URI read from a Secret is assumed
+// to be remote, since it usually carries database credentials.
+func usesRemoteBackendStore(mlflow *mlflowv1.MLflow) bool {
+	switch {
+	case mlflow.Spec.BackendStoreURIFrom != nil:
+		return true
+	case mlflow.Spec.BackendStoreURI != nil:
+		return !strings.HasPrefix(*mlflow.Spec.BackendStoreURI, "sqlite")
+	default:
+		return mlflow.Spec.Database != nil && mlflow.Spec.Database.Connection != nil
+	}
+}
+
+// probesValues converts spec.probes into the chart's probes values. Probe
+// overrides are coalesced over the chart's default timings, so only the
+// fields a user sets change; nil probes keep the chart defaults. With a remote
+// backend store the server needs longer to connect and check the schema, so
+// the readiness probe waits remoteBackendReadinessDelay unless the user sets
+// its initialDelaySeconds.
+func probesValues(probes *mlflowv1.ProbesSpec, remoteBackend bool) (map[string]interface{}, error) {
+	livenessProbePath := defaultLivenessProbePath
+	if probes != nil && probes.Path != nil {
+		livenessProbePath = *probes.Path
+	}
+	values := map[string]interface{}{
+		"path": livenessProbePath,
+	}
+	if remoteBackend && (probes == nil || probes.Readiness == nil || probes.Readiness.InitialDelaySeconds == 0) {
+		values["readiness"] = map[string]interface{}{
+			"initialDelaySeconds": int64(remoteBackendReadinessDelay),
+		}
+	}
+	if probes == nil {
+		return values, nil
+	}
+
+	for key, probe := range map[string]*corev1.Probe{
+		"liveness":  probes.Liveness,
+		"readiness": probes.Readiness,
+		"startup":   probes.Startup,
+	} {
+		if probe == nil {
+			continue
+		}
+		probeMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(probe)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s probe: %w", key, err)
+		}
+		if defaults, ok := values[key].(map[string]interface{}); ok {
+			for field, value := range defaults {
+				if _, set := probeMap[field]; !set {
+					probeMap[field] = value
+				}
+			}
+		}
+		values[key] = probeMap
+	}
+	return values, nil
+}
+
+// databaseReadinessProbe replaces the readiness probe handler with an exec
+// check that also dials the backend database when spec.database.readinessCheck
+// is set. A readiness handler set in spec.probes is kept, and backend URIs read
+// from a Secret are skipped because their host is not known.
+func databaseReadinessProbe(mlflow *mlflowv1.MLflow, probes map[string]interface{}) {
+	if mlflow.Spec.Database == nil || mlflow.Spec.Database.ReadinessCheck == nil || !*mlflow.Spec.Database.ReadinessCheck {
+		return
+	}
+	endpoint, ok := backendStoreEndpoint(mlflow)
+	if !ok {
+		return
+	}
+	readiness, _ := probes["readiness"].(map[string]interface{})
+	if readiness == nil {
+		readiness = map[string]interface{}{}
+	}
+	for _, handler := range []string{"httpGet", "tcpSocket", "exec", "grpc"} {
+		if _, set := readiness[handler]; set {
+			return
+		}
+	}
+	serverInfoPath := strings.TrimSuffix(mlflowBasePath(mlflow), "/") + "/api/3.0/mlflow/server-info"
+	command := make([]interface{}, 0, 3)
+	for _, arg := range databaseReadinessCommand(endpoint, serverInfoPath) {
+		command = append(command, arg)
+	}
+	readiness["exec"] = map[string]interface{}{"command": command}
+	if _, set := readiness["timeoutSeconds"]; !set {
+		readiness["timeoutSeconds"] = int64(databaseReadinessTimeout)
+	}
+	probes["readiness"] = readiness
+}
+
+// databaseReadinessCommand returns a command that fails unless a TCP
+// connection to the database endpoint succeeds and the local server answers on
+// serverInfoPath. It uses the Python interpreter shipped in the MLflow image.
+// The server certificate is issued for the Service name, so the local request
+// skips verification.
+func databaseReadinessCommand(endpoint egressEndpoint, serverInfoPath string) []string {
+	script := fmt.Sprintf("import socket, ssl, urllib.request; "+
+		"socket.create_connection((%q, %d), timeout=2).close(); "+
+		"urllib.request.urlopen(%q, context=ssl._create_unverified_context(), timeout=2)",
+		endpoint.host, endpoint.port, "https://localhost:8443"+serverInfoPath)
+	return []string{"python", "-c", script}
+}
+
+// serviceValues maps spec.service to the chart's service values. Annotations
+// are added by the caller.
+func serviceValues(service *mlflowv1.ServiceSpec) (map[string]interface{}, error) {
+	values := map[string]interface{}{
+		"type":     string(corev1.ServiceTypeClusterIP),
+		"port":     8443,
+		"portName": defaultServicePortName,
+		"headless": false,
+	}
+	if service == nil {
+		return values, nil
+	}
+	serviceType := corev1.ServiceTypeClusterIP
+	if service.Type != nil {
+		serviceType = *service.Type
+	}
+	values["type"] = string(serviceType)
+	if service.Headless != nil {
+		values["headless"] = *service.Headless
+	}
+	if service.PortName != nil {
+		values["portName"] = *service.PortName
+	}
+	if service.NodePort != nil {
+		if serviceType != corev1.ServiceTypeNodePort {
+			return nil, fmt.Errorf("spec.service.nodePort requires spec.service.type NodePort, got %s", serviceType)
+		}
+		values["nodePort"] = *service.NodePort
+	}
+	if service.LoadBalancerIP != nil {
+		if serviceType != corev1.ServiceTypeLoadBalancer {
+			return nil, fmt.Errorf("spec.service.loadBalancerIP requires spec.service.type LoadBalancer, got %s", serviceType)
+		}
+		values["loadBalancerIP"] = *service.LoadBalancerIP
+	}
+	return values, nil
+}
+
+// openShiftRouteValues converts spec.openShift.route into the chart's
+// openShift.route values. The Route is only enabled when the Route API exists.
+func openShiftRouteValues(mlflow *mlflowv1.MLflow, opts RenderOptions) map[string]interface{} {
+	values := map[string]interface{}{
+		"enabled":        false,
+		"tlsTermination": defaultRouteTLSTermination,
+	}
+	// The router only trusts service-ca backend certificates when it
+	// re-encrypts, so a cert-manager certificate is passed through.
+	if certManagerEnabled(mlflow) {
+		values["tlsTermination"] = passthroughRouteTLSTermination
+	}
+	if mlflow.Spec.OpenShift == nil || mlflow.Spec.OpenShift.Route == nil {
+		return values
+	}
+	route := mlflow.Spec.OpenShift.Route
+	values["enabled"] = opts.RouteAvailable && route.Enabled != nil && *route.Enabled
+	if route.Host != nil {
+		values["host"] = *route.Host
+	}
+	if route.TLSTermination != nil {
+		values["tlsTermination"] = *route.TLSTermination
+	}
+	return values
+}
+
+// ingressValues converts spec.ingress into the chart's ingress values.
+// The backend-protocol annotation tells ingress-nginx to re-encrypt to the
+// HTTPS-only server, and streaming turns off its proxy buffering; user
+// annotations take precedence.
+func ingressValues(mlflow *mlflowv1.MLflow) map[string]interface{} {
+	values := map[string]interface{}{
+		"enabled": false,
+	}
+	ingress := mlflow.Spec.Ingress
+	if ingress == nil {
+		return values
+	}
+	values["enabled"] = ingress.Enabled != nil && *ingress.Enabled
+	if ingress.ClassName != nil {
+		values["className"] = *ingress.ClassName
+	}
+	if ingress.Host != nil {
+		values["host"] = *ingress.Host
+	}
+	annotations := map[string]interface{}{
+		ingressBackendProtocolAnnotation: "HTTPS",
+	}
+	if ingress.Streaming != nil && *ingress.Streaming {
+		annotations[ingressProxyBufferingAnnotation] = "off"
+		annotations[ingressRequestBufferingAnnotation] = "off"
+	}
+	for key, value := range ingress.Annotations {
+		annotations[key] = value
+	}
+	values["annotations"] = annotations
+	if len(ingress.TLS) > 0 {
+		tls := make([]interface{}, 0, len(ingress.TLS))
+		for _, entry := range ingress.TLS {
+			item := map[string]interface{}{}
+			if len(entry.Hosts) > 0 {
+				hosts := make([]interface{}, 0, len(entry.Hosts))
+				for _, host := range entry.Hosts {
+					hosts = append(hosts, host)
+				}
+				item["hosts"] = hosts
+			}
+			if entry.SecretName != "" {
+				item["secretName"] = entry.SecretName
+			}
+			tls = append(tls, item)
+		}
+		values["tls"] = tls
+	}
+	return values
+}
+
+// extraVolumeMountValues returns spec.extraVolumeMounts with readOnly forced
+// on mounts of Secret, ConfigMap, projected and downwardAPI extra volumes, so
+// credentials and configuration are never mounted writable.
+func extraVolumeMountValues(mlflow *mlflowv1.MLflow) []corev1.VolumeMount {
+	readOnlyVolumes := sets.New[string]()
+	for _, volume := range mlflow.Spec.ExtraVolumes {
+		if volume.Secret != nil || volume.ConfigMap != nil || volume.Projected != nil || volume.DownwardAPI != nil {
+			readOnlyVolumes.Insert(volume.Name)
+		}
+	}
+	mounts := make([]corev1.VolumeMount, 0, len(mlflow.Spec.ExtraVolumeMounts))
+	for _, mount := range mlflow.Spec.ExtraVolumeMounts {
+		if readOnlyVolumes.Has(mount.Name) {
+			mount.ReadOnly = true
+		}
+		mounts = append(mounts, mount)
+	}
+	return mounts
+}
+
+// serviceAccountTokenContainers returns the spec.serviceAccountToken.containers
+// names, checking that each one is a spec.extraContainers entry.
+func serviceAccountTokenContainers(mlflow *mlflowv1.MLflow) (sets.Set[string], error) {
+	names := sets.New[string]()
+	if mlflow.Spec.ServiceAccountToken == nil {
+		return names, nil
+	}
+	extraContainers := sets.New[string]()
+	for _, container := range mlflow.Spec.ExtraContainers {
+		extraContainers.Insert(container.Name)
+	}
+	for _, name := range mlflow.Spec.ServiceAccountToken.Containers {
+		if !extraContainers.Has(name) {
+			return nil, fmt.Errorf("spec.serviceAccountToken.containers: %q is not a spec.extraContainers entry", name)
+		}
+		names.Insert(name)
+	}
+	return names, nil
+}
+
+// mlflowImageReference returns the MLflow image from spec.image, falling back
+// to defaultImage. Repository and Tag replace the matching part of
+// defaultImage, so an image updater can bump the tag alone.
+func mlflowImageReference(defaultImage string, image *mlflowv1.ImageConfig) (string, error) {
+	if image == nil {
+		return defaultImage, nil
+	}
+	if image.Image != nil && (image.Repository != nil || image.Tag != nil) {
+		return "", fmt.Errorf("spec.image.image cannot be combined with spec.image.repository or spec.image.tag")
+	}
+	if image.Image != nil {
+		return *image.Image, nil
+	}
+	if image.Repository == nil && image.Tag == nil {
+		return defaultImage, nil
+	}
+	repository, tag, digest := splitImage(defaultImage)
+	reference := ""
+	switch {
+	case digest != "":
+		// The immutable digest wins over a tag next to it.
+		reference = "@" + digest
+	case tag != "":
+		reference = ":" + tag
+	}
+	if image.Repository != nil {
+		repository = *image.Repository
+	}
+	if image.Tag != nil {
+		reference = ":" + *image.Tag
+	}
+	return repository + reference, nil
+}
+
+// splitImage splits an image reference into its repository, tag and digest,
+// without the ':' and '@' separators. A registry port is part of the
+// repository; tag and digest are empty when the reference has none.
+func splitImage(ref string) (repository, tag, digest string) {
+	repository = ref
+	if i := strings.Index(ref, "@"); i >= 0 {
+		repository, digest = ref[:i], ref[i+1:]
+	}
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:i], repository[i+1:]
+	}
+	return repository, tag, digest
+}
+
+// certManagerEnabled reports whether spec.tls.certManager provisions the
+// serving certificate.
+func certManagerEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.TLS != nil && mlflow.Spec.TLS.CertManager != nil &&
+		mlflow.Spec.TLS.CertManager.Enabled != nil && *mlflow.Spec.TLS.CertManager.Enabled
+}
+
+// certManagerValues converts spec.tls.certManager into the chart's
+// tls.certManager values, applying the API defaults for the issuer kind and group.
+func certManagerValues(certManager *mlflowv1.CertManagerConfig) (map[string]interface{}, error) {
+	if certManager.IssuerRef == nil || certManager.IssuerRef.Name == "" {
+		return nil, fmt.Errorf("tls.certManager.issuerRef.name is required when certManager is enabled")
+	}
+	issuerRef := map[string]interface{}{
+		"name":  certManager.IssuerRef.Name,
+		"kind":  "Issuer",
+		"group": "cert-manager.io",
+	}
+	if certManager.IssuerRef.Kind != "" {
+		issuerRef["kind"] = certManager.IssuerRef.Kind
+	}
+	if certManager.IssuerRef.Group != "" {
+		issuerRef["group"] = certManager.IssuerRef.Group
+	}
+	values := map[string]interface{}{
+		"enabled":   true,
+		"issuerRef": issuerRef,
+	}
+	if certManager.Duration != nil {
+		values["duration"] = certManager.Duration.Duration.String()
+	}
+	return values, nil
+}
+
+// autoscalingValues maps spec.autoscaling to the chart's autoscaling values,
+// applying the API defaults for minReplicas and the CPU target.
+func autoscalingValues(mlflow *mlflowv1.MLflow) map[string]interface{} {
+	autoscaling := mlflow.Spec.Autoscaling
+	if autoscaling == nil || autoscaling.Enabled == nil || !*autoscaling.Enabled {
+		return map[string]interface{}{"enabled": false}
+	}
+	minReplicas := int32(1)
+	if autoscaling.MinReplicas != nil {
+		minReplicas = *autoscaling.MinReplicas
+	}
+	targetCPU := defaultTargetCPUUtilization
+	if autoscaling.TargetCPUUtilizationPercentage != nil {
+		targetCPU = *autoscaling.TargetCPUUtilizationPercentage
+	}
+	return map[string]interface{}{
+		"enabled":                        true,
+		"minReplicas":                    minReplicas,
+		"maxReplicas":                    autoscaling.MaxReplicas,
+		"targetCPUUtilizationPercentage": targetCPU,
+	}
+}
+
+// s3InsecureSkipVerify reports whether spec.artifactStore.s3.insecureSkipVerify
+// is enabled.
+func s3InsecureSkipVerify(mlflow *mlflowv1.MLflow) bool {
+	store := mlflow.Spec.ArtifactStore
+	return store != nil && store.S3 != nil && store.S3.InsecureSkipVerify != nil && *store.S3.InsecureSkipVerify
+}
+
+// podDisruptionBudgetEnabled reports whether a PodDisruptionBudget is
+// rendered. An explicit spec.podDisruptionBudget.enabled always wins; when it
+// is unset, a budget is added for Deployments that run more than one replica.
+func podDisruptionBudgetEnabled(mlflow *mlflowv1.MLflow) bool {
+	pdb := mlflow.Spec.PodDisruptionBudget
+	if pdb != nil && pdb.Enabled != nil {
+		return *pdb.Enabled
+	}
+	return minimumReplicas(mlflow) > 1
+}
+
+// minimumReplicas returns the fewest replicas the Deployment runs outside of
+// migrations: autoscaling.minReplicas while autoscaling is enabled, otherwise
+// spec.replicas.
+func minimumReplicas(mlflow *mlflowv1.MLflow) int32 {
+	if autoscaling := mlflow.Spec.Autoscaling; autoscaling != nil && autoscaling.Enabled != nil && *autoscaling.Enabled {
+		if autoscaling.MinReplicas != nil {
+			return *autoscaling.MinReplicas
+		}
+		return 1
+	}
+	if mlflow.Spec.Replicas != nil {
+		return *mlflow.Spec.Replicas
+	}
+	return 1
+}
+
+// podDisruptionBudgetValues maps spec.podDisruptionBudget to the chart's
+// podDisruptionBudget values. When neither bound is set, an explicitly
+// enabled budget defaults to minAvailable 1 and the automatic budget for
+// multi-replica Deployments to maxUnavailable 1.
+func podDisruptionBudgetValues(mlflow *mlflowv1.MLflow) (map[string]interface{}, error) {
+	if !podDisruptionBudgetEnabled(mlflow) {
+		return map[string]interface{}{"enabled": false}, nil
+	}
+	pdb := mlflow.Spec.PodDisruptionBudget
+	if pdb == nil {
+		pdb = &mlflowv1.PDBConfig{}
+	}
+	if pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+		return nil, fmt.Errorf("spec.podDisruptionBudget.minAvailable and maxUnavailable are mutually exclusive")
+	}
+	values := map[string]interface{}{"enabled": true}
+	switch {
+	case pdb.MaxUnavailable != nil:
+		values["maxUnavailable"] = intOrStringValue(*pdb.MaxUnavailable)
+	case pdb.MinAvailable != nil:
+		values["minAvailable"] = intOrStringValue(*pdb.MinAvailable)
+	case pdb.Enabled == nil:
+		values["maxUnavailable"] = int32(1)
+	default:
+		values["minAvailable"] = int32(1)
+	}
+	return values, nil
+}
+
+// intOrStringValue keeps an IntOrString's type in Helm values, so a count
+// renders as an integer and a percentage as a string.
+func intOrStringValue(v intstr.IntOrString) interface{} {
+	if v.Type == intstr.String {
+		return v.StrVal
+	}
+	return v.IntVal
+}
+
+// authorizationModeValue maps spec.auth.authorizationMode to the
+// MLFLOW_K8S_AUTH_AUTHORIZATION_MODE value the kubernetes-auth app expects.
+func authorizationModeValue(mlflow *mlflowv1.MLflow) (string, error) {
+	mode := mlflowv1.MLflowAuthorizationSelfSubjectAccessReview
+	if mlflow.Spec.Auth != nil && mlflow.Spec.Auth.AuthorizationMode != "" {
+		mode = mlflow.Spec.Auth.AuthorizationMode
+	}
+	switch mode {
+	case mlflowv1.MLflowAuthorizationSelfSubjectAccessReview:
+		return "self_subject_access_review", nil
+	case mlflowv1.MLflowAuthorizationSubjectAccessReview:
+		return "subject_access_review", nil
+	default:
+		return "", fmt.Errorf("unsupported spec.auth.authorizationMode %q", mode)
+	}
+}
+
+// defaultWorkers returns spec.workers when set. Otherwise it sizes the worker
+// pool from the container's CPU request with the 2*cores+1 rule, so workers do
+// not oversubscribe the CPU the pod is scheduled with, and caps the result at
+// maxDerivedWorkers because every uvicorn worker loads its own copy of MLflow.
+func defaultWorkers(mlflow *mlflowv1.MLflow) int32 {
+	if mlflow.Spec.Workers != nil {
+		return *mlflow.Spec.Workers
+	}
+	if mlflow.Spec.Resources == nil {
+		return 1
+	}
+	cpu, ok := mlflow.Spec.Resources.Requests[corev1.ResourceCPU]
+	if !ok || cpu.IsZero() {
+		return 1
+	}
+	workers := 2*cpu.MilliValue()/1000 + 1
+	if workers > maxDerivedWorkers {
+		return maxDerivedWorkers
+	}
+	return int32(workers)
+}
+
+// s3AssumeRoleValues returns the artifactStore.s3.assumeRole Helm values. It
+// repeats the CRD's combination rules so an invalid spec never renders a
+// profile that botocore would reject at request time.
+func s3AssumeRoleValues(mlflow *mlflowv1.MLflow) (map[string]interface{}, error) {
+	values := map[string]interface{}{
+		"roleArn":       "",
+		"sourceRoleArn": "",
+		"externalId":    "",
+	}
+	if mlflow.Spec.ArtifactStore == nil || mlflow.Spec.ArtifactStore.S3 == nil {
+		return values, nil
+	}
+	s3 := mlflow.Spec.ArtifactStore.S3
+	if s3.SourceRoleARN != nil && s3.AssumeRoleARN == nil {
+		return nil, fmt.Errorf("spec.artifactStore.s3.sourceRoleARN requires assumeRoleARN")
+	}
+	if s3.ExternalID != nil && s3.SourceRoleARN == nil {
+		return nil, fmt.Errorf("spec.artifactStore.s3.externalID requires sourceRoleARN")
+	}
+	if s3.AssumeRoleARN != nil {
+		values["roleArn"] = *s3.AssumeRoleARN
+	}
+	if s3.SourceRoleARN != nil {
+		values["sourceRoleArn"] = *s3.SourceRoleARN
+	}
+	if s3.ExternalID != nil {
+		values["externalId"] = *s3.ExternalID
+	}
+	return values, nil
+}
+
+// s3CredentialsValues returns the artifactStore.s3.credentials Helm values,
+// the valueFrom of each static credential environment variable. Like
+// s3AssumeRoleValues it repeats the CRD's combination rules.
+func s3CredentialsValues(mlflow *mlflowv1.MLflow) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if mlflow.Spec.ArtifactStore == nil || mlflow.Spec.ArtifactStore.S3 == nil {
+		return values, nil
+	}
+	s3 := mlflow.Spec.ArtifactStore.S3
+	if (s3.AccessKeyIDSecret == nil) != (s3.SecretAccessKeySecret == nil) {
+		return nil, fmt.Errorf("spec.artifactStore.s3.accessKeyIDSecret and secretAccessKeySecret must be set together")
+	}
+	if s3.SessionTokenSecret != nil && s3.AccessKeyIDSecret == nil {
+		return nil, fmt.Errorf("spec.artifactStore.s3.sessionTokenSecret requires accessKeyIDSecret and secretAccessKeySecret")
+	}
+	if s3.AccessKeyIDSecret != nil && s3.AssumeRoleARN != nil {
+		return nil, fmt.Errorf("spec.artifactStore.s3.accessKeyIDSecret cannot be combined with assumeRoleARN")
+	}
+	if s3.AccessKeyIDSecret != nil {
+		values["accessKeyId"] = secretKeyRefValues(s3.AccessKeyIDSecret)
+		values["secretAccessKey"] = secretKeyRefValues(s3.SecretAccessKeySecret)
+	}
+	if s3.SessionTokenSecret != nil {
+		values["sessionToken"] = secretKeyRefValues(s3.SessionTokenSecret)
+	}
+	return values, nil
+}
+
 // mlflowToHelmValues converts MLflow CR spec to Helm values
 func (h *HelmRenderer) mlflowToHelmValues(
 	mlflow *mlflowv1.MLflow,
@@ -190,16 +1035,26 @@ func (h *HelmRenderer) mlflowToHelmValues(
 	if len(mlflow.Spec.PodLabels) > 0 {
 		podLabels := make(map[string]interface{})
 		for k, v := range mlflow.Spec.PodLabels {
+			// The API rejects reserved keys; skip them here too so a label can
+			// never change the Deployment selector or the component label.
+			if reservedPodLabels[k] {
+				continue
+			}
 			podLabels[k] = v
 		}
-		values["podLabels"] = podLabels
+		if len(podLabels) > 0 {
+			values["podLabels"] = podLabels
+		}
 	}
 
-	if len(mlflow.Spec.PodAnnotations) > 0 {
-		podAnnotations := make(map[string]interface{})
-		for k, v := range mlflow.Spec.PodAnnotations {
-			podAnnotations[k] = v
-		}
+	podAnnotations := make(map[string]interface{})
+	for k, v := range mlflow.Spec.PodAnnotations {
+		podAnnotations[k] = v
+	}
+	if opts.ReferencedDataHash != "" {
+		podAnnotations[referencedDataHashAnnotation] = opts.ReferencedDataHash
+	}
+	if len(podAnnotations) > 0 {
 		values["podAnnotations"] = podAnnotations
 	}
 
@@ -218,6 +1073,15 @@ func (h *HelmRenderer) mlflowToHelmValues(
 	} else {
 		tlsValues["defaultMode"] = 420 // 0644 — world-readable; no fsGroup on vanilla K8s
 	}
+	useCertManager := certManagerEnabled(mlflow)
+	if useCertManager {
+		certManagerValues, err := certManagerValues(mlflow.Spec.TLS.CertManager)
+		if err != nil {
+			return nil, err
+		}
+		tlsValues["certManager"] = certManagerValues
+		tlsValues["upstreamCAFile"] = certManagerCAFile
+	}
 
 	values["tls"] = tlsValues
 
@@ -248,19 +1112,32 @@ func (h *HelmRenderer) mlflowToHelmValues(
 		})
 	}
 
+	// Add the artifact store CA Secret; the chart mounts entries marked
+	// secret as Secret volumes.
+	if store := mlflow.Spec.ArtifactStore; store != nil && store.CABundleSecret != nil {
+		caConfigMaps = append(caConfigMaps, map[string]interface{}{
+			"name":      *store.CABundleSecret,
+			"mountPath": caArtifactStoreMount,
+			"secret":    true,
+		})
+	}
+
 	values["caBundle"] = map[string]interface{}{
 		"configMaps": caConfigMaps,
 		"filePaths":  caFilePaths,
+		// The artifact store CA only covers S3, so it does not switch the
+		// database clients to verify-full.
+		"databaseTLS": opts.PlatformTrustedCABundleExists || mlflow.Spec.CABundleConfigMap != nil,
 	}
 
 	// Use config from environment variables as default, can be overridden by CR spec
-	mlflowImage := effectiveCfg.MLflowImage
+	mlflowImage, err := mlflowImageReference(effectiveCfg.MLflowImage, mlflow.Spec.Image)
+	if err != nil {
+		return nil, err
+	}
 	var imagePullPolicy *string
 
 	if mlflow.Spec.Image != nil {
-		if mlflow.Spec.Image.Image != nil {
-			mlflowImage = *mlflow.Spec.Image.Image
-		}
 		if mlflow.Spec.Image.ImagePullPolicy != nil {
 			policy := string(*mlflow.Spec.Image.ImagePullPolicy)
 			imagePullPolicy = &policy
@@ -274,12 +1151,25 @@ func (h *HelmRenderer) mlflowToHelmValues(
 		imageValues["imagePullPolicy"] = *imagePullPolicy
 	}
 	values["image"] = imageValues
+	values["defaultExperiment"] = defaultExperimentValues(mlflow, mlflowImage)
 
-	replicas := int32(1)
-	if mlflow.Spec.Replicas != nil {
-		replicas = *mlflow.Spec.Replicas
+	podDisruptionBudget, err := podDisruptionBudgetValues(mlflow)
+	if err != nil {
+		return nil, err
+	}
+	values["podDisruptionBudget"] = podDisruptionBudget
+
+	// The HorizontalPodAutoscaler owns the replica count when autoscaling is
+	// enabled, so no fixed replicaCount is emitted.
+	autoscaling := autoscalingValues(mlflow)
+	values["autoscaling"] = autoscaling
+	if !autoscaling["enabled"].(bool) {
+		replicas := int32(1)
+		if mlflow.Spec.Replicas != nil {
+			replicas = *mlflow.Spec.Replicas
+		}
+		values["replicaCount"] = replicas
 	}
-	values["replicaCount"] = replicas
 
 	if mlflow.Spec.Resources != nil {
 		resourcesMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mlflow.Spec.Resources)
@@ -287,6 +1177,15 @@ func (h *HelmRenderer) mlflowToHelmValues(
 			return nil, fmt.Errorf("failed to convert resources: %w", err)
 		}
 		values["resources"] = resourcesMap
+	} else if opts.ContainerResourceBounds != nil {
+		// Fit the chart defaults into the namespace LimitRange so pods are not
+		// rejected at admission. Explicit spec.resources are never adjusted.
+		resources := fitResourcesToBounds(defaultMLflowResources(), opts.ContainerResourceBounds)
+		resourcesMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&resources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert resources: %w", err)
+		}
+		values["resources"] = resourcesMap
 	}
 
 	// Storage - only enabled if explicitly configured
@@ -318,12 +1217,68 @@ func (h *HelmRenderer) mlflowToHelmValues(
 		}
 	}
 
-	values["storage"] = map[string]interface{}{
+	// An existing claim is mounted instead of the chart's PVC, which is then
+	// not rendered.
+	if mlflow.Spec.ExistingStorageClaim != nil {
+		storageEnabled = true
+	}
+
+	storageValues := map[string]interface{}{
 		"enabled":          storageEnabled,
 		"size":             storageSize,
 		"storageClassName": storageClassName,
 		"accessMode":       accessMode,
 	}
+	if mlflow.Spec.ExistingStorageClaim != nil {
+		storageValues["existingClaim"] = *mlflow.Spec.ExistingStorageClaim
+	}
+	if mlflow.Spec.StorageClaimName != nil {
+		storageValues["claimName"] = *mlflow.Spec.StorageClaimName
+	}
+	if mlflow.Spec.ArtifactsSubPath != nil {
+		storageValues["artifactsSubPath"] = *mlflow.Spec.ArtifactsSubPath
+	}
+	if mlflow.Spec.ArtifactsReadOnly != nil && *mlflow.Spec.ArtifactsReadOnly {
+		if mlflow.Spec.ArtifactsSubPath == nil {
+			return nil, fmt.Errorf("artifactsReadOnly requires artifactsSubPath to be set")
+		}
+		if accessMode != string(corev1.ReadWriteMany) && accessMode != string(corev1.ReadOnlyMany) {
+			return nil, fmt.Errorf("artifactsReadOnly requires a ReadWriteMany or ReadOnlyMany storage access mode, got %q", accessMode)
+		}
+		storageValues["artifactsReadOnly"] = true
+		storageValues["readOnly"] = accessMode == string(corev1.ReadOnlyMany)
+	}
+
+	tmpValues := map[string]interface{}{
+		"sizeLimit": defaultTmpVolumeSizeLimit,
+	}
+	if mlflow.Spec.TmpVolumeSizeLimit != nil {
+		tmpValues["sizeLimit"] = mlflow.Spec.TmpVolumeSizeLimit.String()
+	}
+
+	values["storage"] = storageValues
+	values["tmp"] = tmpValues
+
+	probes, err := probesValues(mlflow.Spec.Probes, usesRemoteBackendStore(mlflow))
+	if err != nil {
+		return nil, err
+	}
+	databaseReadinessProbe(mlflow, probes)
+	values["probes"] = probes
+
+	shutdownDelaySeconds := defaultShutdownDelay
+	if mlflow.Spec.ShutdownDelaySeconds != nil {
+		shutdownDelaySeconds = *mlflow.Spec.ShutdownDelaySeconds
+	}
+	values["lifecycle"] = map[string]interface{}{
+		"shutdownDelaySeconds": shutdownDelaySeconds,
+	}
+
+	terminationMessagePolicy := corev1.TerminationMessageFallbackToLogsOnError
+	if mlflow.Spec.TerminationMessagePolicy != nil {
+		terminationMessagePolicy = *mlflow.Spec.TerminationMessagePolicy
+	}
+	values["terminationMessagePolicy"] = string(terminationMessagePolicy)
 
 	backendStoreURI := ""
 	artifactsDest := defaultArtifactsDest
@@ -331,17 +1286,11 @@ func (h *HelmRenderer) mlflowToHelmValues(
 	// BackendStoreURI: prefer secret ref over direct value
 	var backendStoreURIFrom map[string]interface{}
 	if mlflow.Spec.BackendStoreURIFrom != nil {
-		backendStoreURIFrom = map[string]interface{}{
-			"secretKeyRef": map[string]interface{}{
-				"name": mlflow.Spec.BackendStoreURIFrom.Name,
-				"key":  mlflow.Spec.BackendStoreURIFrom.Key,
-			},
-		}
-		if mlflow.Spec.BackendStoreURIFrom.Optional != nil {
-			backendStoreURIFrom["secretKeyRef"].(map[string]interface{})["optional"] = *mlflow.Spec.BackendStoreURIFrom.Optional
-		}
+		backendStoreURIFrom = secretKeyRefValues(mlflow.Spec.BackendStoreURIFrom)
 	} else if mlflow.Spec.BackendStoreURI != nil {
 		backendStoreURI = *mlflow.Spec.BackendStoreURI
+	} else if mlflow.Spec.Database != nil && mlflow.Spec.Database.Connection != nil {
+		backendStoreURI = databaseConnectionURI(mlflow.Spec.Database.Connection)
 	} else {
 		// Preserve the legacy implicit SQLite default for already-stored CRs that
 		// predate the explicit backendStoreUri validation. New creates and updates
@@ -354,15 +1303,7 @@ func (h *HelmRenderer) mlflowToHelmValues(
 	readReplicaBackendStoreURI := ""
 	var readReplicaBackendStoreURIFrom map[string]interface{}
 	if mlflow.Spec.ReadReplicaBackendStoreURIFrom != nil {
-		readReplicaBackendStoreURIFrom = map[string]interface{}{
-			"secretKeyRef": map[string]interface{}{
-				"name": mlflow.Spec.ReadReplicaBackendStoreURIFrom.Name,
-				"key":  mlflow.Spec.ReadReplicaBackendStoreURIFrom.Key,
-			},
-		}
-		if mlflow.Spec.ReadReplicaBackendStoreURIFrom.Optional != nil {
-			readReplicaBackendStoreURIFrom["secretKeyRef"].(map[string]interface{})["optional"] = *mlflow.Spec.ReadReplicaBackendStoreURIFrom.Optional
-		}
+		readReplicaBackendStoreURIFrom = secretKeyRefValues(mlflow.Spec.ReadReplicaBackendStoreURIFrom)
 	} else if mlflow.Spec.ReadReplicaBackendStoreURI != nil {
 		readReplicaBackendStoreURI = *mlflow.Spec.ReadReplicaBackendStoreURI
 	}
@@ -372,15 +1313,7 @@ func (h *HelmRenderer) mlflowToHelmValues(
 	var registryStoreURIFrom map[string]interface{}
 	registryStoreURI := backendStoreURI // Default to backend URI when provided
 	if mlflow.Spec.RegistryStoreURIFrom != nil {
-		registryStoreURIFrom = map[string]interface{}{
-			"secretKeyRef": map[string]interface{}{
-				"name": mlflow.Spec.RegistryStoreURIFrom.Name,
-				"key":  mlflow.Spec.RegistryStoreURIFrom.Key,
-			},
-		}
-		if mlflow.Spec.RegistryStoreURIFrom.Optional != nil {
-			registryStoreURIFrom["secretKeyRef"].(map[string]interface{})["optional"] = *mlflow.Spec.RegistryStoreURIFrom.Optional
-		}
+		registryStoreURIFrom = secretKeyRefValues(mlflow.Spec.RegistryStoreURIFrom)
 	} else if mlflow.Spec.RegistryStoreURI != nil {
 		registryStoreURI = *mlflow.Spec.RegistryStoreURI
 	} else if backendStoreURIFrom != nil {
@@ -389,10 +1322,35 @@ func (h *HelmRenderer) mlflowToHelmValues(
 	}
 	// Otherwise registryStoreURI already defaults to backendStoreURI
 
-	if mlflow.Spec.ArtifactsDestination != nil {
+	// ArtifactsDestination: prefer secret ref over direct value
+	var artifactsDestinationFrom map[string]interface{}
+	if mlflow.Spec.ArtifactsDestinationFrom != nil {
+		if mlflow.Spec.ArtifactsDestination != nil {
+			return nil, fmt.Errorf("artifactsDestination and artifactsDestinationFrom are mutually exclusive")
+		}
+		artifactsDestinationFrom = secretKeyRefValues(mlflow.Spec.ArtifactsDestinationFrom)
+	} else if mlflow.Spec.ArtifactsDestination != nil {
 		artifactsDest = *mlflow.Spec.ArtifactsDestination
 	}
 
+	// ArtifactsSubPath mounts a subdirectory of the storage PVC at a dedicated
+	// path. An unset artifacts destination defaults to that mount; an explicit
+	// file:// destination is kept as set.
+	if mlflow.Spec.ArtifactsSubPath != nil {
+		if artifactsDestinationFrom != nil {
+			return nil, fmt.Errorf("artifactsSubPath cannot be combined with artifactsDestinationFrom")
+		}
+		if !storageEnabled {
+			return nil, fmt.Errorf("artifactsSubPath requires storage to be configured")
+		}
+		if !strings.HasPrefix(artifactsDest, "file://") {
+			return nil, fmt.Errorf("artifactsSubPath can only be used with file-based artifactsDestination, got %q", artifactsDest)
+		}
+		if mlflow.Spec.ArtifactsDestination == nil {
+			artifactsDest = "file://" + artifactsSubPathMount
+		}
+	}
+
 	// DefaultArtifactRoot: only set if user explicitly specifies it. This is required when
 	// serveArtifacts is false.
 	// When unset, MLflow uses intelligent defaults when serveArtifacts is true:
@@ -401,19 +1359,13 @@ func (h *HelmRenderer) mlflowToHelmValues(
 		defaultArtifactRoot = *mlflow.Spec.DefaultArtifactRoot
 	}
 
-	// Wildcard to allow all hosts
-	allowedHosts := []string{"*"}
-
 	// Defaults to false, but MUST be true when using file-based artifact storage
 	serveArtifacts := false
 	if mlflow.Spec.ServeArtifacts != nil {
 		serveArtifacts = *mlflow.Spec.ServeArtifacts
 	}
 
-	workers := int32(1)
-	if mlflow.Spec.Workers != nil {
-		workers = *mlflow.Spec.Workers
-	}
+	workers := defaultWorkers(mlflow)
 
 	var workspaceLabelSelector string
 	if mlflow.Spec.WorkspaceLabelSelector != nil {
@@ -435,37 +1387,92 @@ func (h *HelmRenderer) mlflowToHelmValues(
 		"serveArtifacts":             serveArtifacts,
 		"workers":                    workers,
 		"port":                       8443,
-		"allowedHosts":               allowedHosts,
-		"staticPrefix":               StaticPrefix, // Hardcoded for operator deployments
+		"allowedHosts":               buildAllowedHosts(mlflow, namespace, effectiveCfg, opts.ObservedHosts),
+		"staticPrefix":               mlflowBasePath(mlflow),
 	}
 
 	if workspaceLabelSelector != "" {
 		mlflowConfig["workspaceLabelSelector"] = workspaceLabelSelector
 	}
+	if mlflow.Spec.MaxRequestHeaderBytes != nil {
+		mlflowConfig["maxRequestHeaderBytes"] = *mlflow.Spec.MaxRequestHeaderBytes
+	}
+	if timeouts := mlflow.Spec.ServerTimeouts; timeouts != nil {
+		if timeouts.KeepAliveSeconds != nil {
+			mlflowConfig["timeoutKeepAlive"] = *timeouts.KeepAliveSeconds
+		}
+		if timeouts.GracefulShutdownSeconds != nil {
+			mlflowConfig["timeoutGracefulShutdown"] = *timeouts.GracefulShutdownSeconds
+		}
+	}
+	authorizationMode, err := authorizationModeValue(mlflow)
+	if err != nil {
+		return nil, err
+	}
+	mlflowConfig["authorizationMode"] = authorizationMode
+	if mlflow.Spec.Logging != nil && mlflow.Spec.Logging.AccessLog != nil {
+		mlflowConfig["accessLog"] = *mlflow.Spec.Logging.AccessLog
+	}
+	if len(mlflow.Spec.ExtraArgs) > 0 {
+		mlflowConfig["extraArgs"] = mlflow.Spec.ExtraArgs
+	}
 
 	// Add secret references if provided
 	if backendStoreURIFrom != nil {
 		mlflowConfig["backendStoreUriFrom"] = backendStoreURIFrom
 	}
+	if passwordFrom := databasePasswordFrom(mlflow); passwordFrom != nil {
+		mlflowConfig["backendStorePasswordFrom"] = passwordFrom
+	}
 	if readReplicaBackendStoreURIFrom != nil {
 		mlflowConfig["readReplicaBackendStoreUriFrom"] = readReplicaBackendStoreURIFrom
 	}
 	if registryStoreURIFrom != nil {
 		mlflowConfig["registryStoreUriFrom"] = registryStoreURIFrom
 	}
+	if artifactsDestinationFrom != nil {
+		mlflowConfig["artifactsDestinationFrom"] = artifactsDestinationFrom
+	}
 
 	mlflowConfig["corsAllowedOrigins"] = buildCORSAllowedOrigins(mlflow, namespace, effectiveCfg)
 
 	values["mlflow"] = mlflowConfig
+	values["effectiveConfig"] = effectiveConfigValues(mlflow, mlflowConfig)
 
-	envCapacity := len(mlflow.Spec.Env)
-	if opts.IsOpenShift {
-		envCapacity++
+	forcePathStyle := false
+	if mlflow.Spec.ArtifactStore != nil && mlflow.Spec.ArtifactStore.S3 != nil && mlflow.Spec.ArtifactStore.S3.ForcePathStyle != nil {
+		forcePathStyle = *mlflow.Spec.ArtifactStore.S3.ForcePathStyle
 	}
-	env := make([]interface{}, 0, envCapacity)
+	assumeRole, err := s3AssumeRoleValues(mlflow)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := s3CredentialsValues(mlflow)
+	if err != nil {
+		return nil, err
+	}
+	// An MLFLOW_S3_ENDPOINT_URL entry in spec.env wins over endpointURL, so
+	// the chart does not render the variable twice.
+	endpointURL := ""
+	if store := mlflow.Spec.ArtifactStore; store != nil && store.S3 != nil && store.S3.EndpointURL != nil &&
+		!slices.ContainsFunc(mlflow.Spec.Env, func(e corev1.EnvVar) bool { return e.Name == s3EndpointURLEnv }) {
+		endpointURL = *store.S3.EndpointURL
+	}
+	values["artifactStore"] = map[string]interface{}{
+		"s3": map[string]interface{}{
+			"endpointUrl":        endpointURL,
+			"forcePathStyle":     forcePathStyle,
+			"insecureSkipVerify": s3InsecureSkipVerify(mlflow),
+			"assumeRole":         assumeRole,
+			"credentials":        credentials,
+		},
+	}
+
+	env := make([]interface{}, 0, len(mlflow.Spec.Env)+2)
 	hasCustomUvicornSSLCiphers := false
 
-	// Add custom env vars from spec
+	// Add custom env vars from spec. Keep spec order and append operator
+	// defaults afterwards so the rendered env list is stable across reconciles.
 	for i, e := range mlflow.Spec.Env {
 		if opts.IsOpenShift && e.Name == uvicornSSLCiphersEnv {
 			hasCustomUvicornSSLCiphers = true
@@ -489,6 +1496,20 @@ func (h *HelmRenderer) mlflowToHelmValues(
 		})
 	}
 
+	// Admission rejects pool settings with a SQLite store; a store that
+	// bypasses it, such as the legacy implicit SQLite backend, ignores them.
+	// Env set in spec.env wins.
+	if usesRemoteBackendStore(mlflow) {
+		for _, poolEnv := range databasePoolEnv(mlflow.Spec.Database) {
+			if !slices.ContainsFunc(mlflow.Spec.Env, func(e corev1.EnvVar) bool { return e.Name == poolEnv.Name }) {
+				env = append(env, map[string]interface{}{
+					"name":  poolEnv.Name,
+					"value": poolEnv.Value,
+				})
+			}
+		}
+	}
+
 	values["env"] = env
 
 	if len(mlflow.Spec.EnvFrom) > 0 {
@@ -512,26 +1533,43 @@ func (h *HelmRenderer) mlflowToHelmValues(
 		"name":   serviceAccountName,
 	}
 
-	// Add OpenShift service-ca annotation for automatic cert provisioning
-	serviceAnnotations := map[string]interface{}{
-		"service.beta.openshift.io/serving-cert-secret-name": tlsSecretName,
+	// Add OpenShift service-ca annotation for automatic cert provisioning, unless
+	// cert-manager owns the Secret instead.
+	serviceAnnotations := map[string]interface{}{}
+	if !useCertManager {
+		serviceAnnotations["service.beta.openshift.io/serving-cert-secret-name"] = tlsSecretName
 	}
 
-	values["service"] = map[string]interface{}{
-		"type":        "ClusterIP",
-		"port":        8443,
-		"annotations": serviceAnnotations,
+	serviceValues, err := serviceValues(mlflow.Spec.Service)
+	if err != nil {
+		return nil, err
+	}
+	serviceValues["annotations"] = serviceAnnotations
+	values["service"] = serviceValues
+	values["openShift"] = map[string]interface{}{
+		"route": openShiftRouteValues(mlflow, opts),
 	}
+	values["ingress"] = ingressValues(mlflow)
 
 	// Metrics configuration - only enabled when the ServiceMonitor CRD is present in the cluster.
+	// With cert-manager, verify against the CA it writes into the TLS Secret.
 	// On OpenShift, configure service-ca-based TLS verification for Prometheus scraping.
 	// On non-OpenShift clusters, fall back to insecureSkipVerify.
 	metricsConfig := map[string]interface{}{
 		"enabled": opts.ServiceMonitorAvailable,
 	}
-	if opts.IsOpenShift {
-		serviceName := "mlflow" + getResourceSuffix(mlflow.Name)
-		serverName := fmt.Sprintf("%s.%s.svc", serviceName, namespace)
+	serverName := fmt.Sprintf("mlflow%s.%s.svc", getResourceSuffix(mlflow.Name), namespace)
+	if useCertManager {
+		metricsConfig["tlsConfig"] = map[string]interface{}{
+			"ca": map[string]interface{}{
+				"secret": map[string]interface{}{
+					"name": tlsSecretName,
+					"key":  certManagerCAKey,
+				},
+			},
+			"serverName": serverName,
+		}
+	} else if opts.IsOpenShift {
 		metricsConfig["tlsConfig"] = map[string]interface{}{
 			"ca": map[string]interface{}{
 				"configMap": map[string]interface{}{
@@ -546,13 +1584,33 @@ func (h *HelmRenderer) mlflowToHelmValues(
 			"insecureSkipVerify": true,
 		}
 	}
+	if mlflow.Spec.Monitoring != nil {
+		for key, relabelings := range map[string][]mlflowv1.RelabelConfig{
+			"metricRelabelings": mlflow.Spec.Monitoring.MetricRelabelings,
+			"relabelings":       mlflow.Spec.Monitoring.Relabelings,
+		} {
+			if len(relabelings) == 0 {
+				continue
+			}
+			configs := make([]interface{}, 0, len(relabelings))
+			for i := range relabelings {
+				configMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&relabelings[i])
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert monitoring.%s[%d]: %w", key, i, err)
+				}
+				configs = append(configs, configMap)
+			}
+			metricsConfig[key] = configs
+		}
+	}
 	values["metrics"] = metricsConfig
 
 	if mlflow.Spec.PodSecurityContext != nil {
-		// Convert PodSecurityContext to map
-		// For now, we'll pass through the whole object as-is
-		// Helm templates will handle the YAML marshaling
-		values["podSecurityContext"] = mlflow.Spec.PodSecurityContext
+		podSecurityContextMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mlflow.Spec.PodSecurityContext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert podSecurityContext: %w", err)
+		}
+		values["podSecurityContext"] = podSecurityContextMap
 	} else {
 		values["podSecurityContext"] = map[string]interface{}{
 			"runAsNonRoot": true,
@@ -563,7 +1621,11 @@ func (h *HelmRenderer) mlflowToHelmValues(
 	}
 
 	if mlflow.Spec.SecurityContext != nil {
-		values["securityContext"] = mlflow.Spec.SecurityContext
+		securityContextMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mlflow.Spec.SecurityContext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert securityContext: %w", err)
+		}
+		values["securityContext"] = securityContextMap
 	} else {
 		values["securityContext"] = map[string]interface{}{
 			"allowPrivilegeEscalation": false,
@@ -586,20 +1648,96 @@ func (h *HelmRenderer) mlflowToHelmValues(
 		values["tolerations"] = []corev1.Toleration{}
 	}
 
+	if len(mlflow.Spec.SchedulingGates) > 0 {
+		values["schedulingGates"] = mlflow.Spec.SchedulingGates
+	} else {
+		values["schedulingGates"] = []corev1.PodSchedulingGate{}
+	}
+
+	if mlflow.Spec.PriorityClassName != nil {
+		values["priorityClassName"] = *mlflow.Spec.PriorityClassName
+	}
+
+	if mlflow.Spec.TerminationGracePeriodSeconds != nil {
+		values["terminationGracePeriodSeconds"] = *mlflow.Spec.TerminationGracePeriodSeconds
+	}
+
+	if mlflow.Spec.Hostname != nil {
+		values["hostname"] = *mlflow.Spec.Hostname
+	}
+
+	if mlflow.Spec.Subdomain != nil {
+		values["subdomain"] = *mlflow.Spec.Subdomain
+	}
+
+	if len(mlflow.Spec.HostAliases) > 0 {
+		values["hostAliases"] = mlflow.Spec.HostAliases
+	} else {
+		values["hostAliases"] = []corev1.HostAlias{}
+	}
+
 	if len(mlflow.Spec.ResourceClaims) > 0 {
 		values["resourceClaims"] = mlflow.Spec.ResourceClaims
 	} else {
 		values["resourceClaims"] = []corev1.PodResourceClaim{}
 	}
 
+	// Converted to maps so quantities such as emptyDir.sizeLimit survive the
+	// Helm values copy.
+	extraVolumes := make([]interface{}, 0, len(mlflow.Spec.ExtraVolumes))
+	for i := range mlflow.Spec.ExtraVolumes {
+		volumeMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&mlflow.Spec.ExtraVolumes[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert extraVolumes[%d]: %w", i, err)
+		}
+		extraVolumes = append(extraVolumes, volumeMap)
+	}
+	values["extraVolumes"] = extraVolumes
+
+	values["extraVolumeMounts"] = extraVolumeMountValues(mlflow)
+
+	tokenContainers, err := serviceAccountTokenContainers(mlflow)
+	if err != nil {
+		return nil, err
+	}
+	values["serviceAccountToken"] = map[string]interface{}{
+		"projected": mlflow.Spec.ServiceAccountToken != nil,
+		"mountPath": serviceAccountTokenMountPath,
+	}
+
+	extraContainers := make([]interface{}, 0, len(mlflow.Spec.ExtraContainers))
+	for i := range mlflow.Spec.ExtraContainers {
+		container := mlflow.Spec.ExtraContainers[i].DeepCopy()
+		if tokenContainers.Has(container.Name) {
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      serviceAccountTokenVolume,
+				MountPath: serviceAccountTokenMountPath,
+				ReadOnly:  true,
+			})
+		}
+		containerMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(container)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert extraContainers[%d]: %w", i, err)
+		}
+		extraContainers = append(extraContainers, containerMap)
+	}
+	values["extraContainers"] = extraContainers
+
 	if mlflow.Spec.Affinity != nil {
 		values["affinity"] = mlflow.Spec.Affinity
 	} else {
 		values["affinity"] = map[string]interface{}{}
 	}
 
-	egressRules := make([]interface{}, 0, len(mlflow.Spec.NetworkPolicyEgressRules))
-	for i, rule := range mlflow.Spec.NetworkPolicyEgressRules {
+	baseEgressRules := mlflow.Spec.NetworkPolicyEgressRules
+	if restrictEgress(mlflow) {
+		if len(baseEgressRules) > 0 {
+			return nil, fmt.Errorf("networkPolicy.restrictEgress cannot be combined with networkPolicyEgressRules")
+		}
+		baseEgressRules = restrictedEgressRules(mlflow)
+	}
+	egressRules := make([]interface{}, 0, len(baseEgressRules))
+	for i, rule := range baseEgressRules {
 		ruleMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&rule)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert networkPolicyEgressRules[%d]: %w", i, err)
@@ -614,9 +1752,32 @@ func (h *HelmRenderer) mlflowToHelmValues(
 		}
 		additionalEgressRules = append(additionalEgressRules, ruleMap)
 	}
+	var ingressPeers []networkingv1.NetworkPolicyPeer
+	if mlflow.Spec.NetworkPolicy != nil {
+		ingressPeers = mlflow.Spec.NetworkPolicy.IngressFrom
+	}
+	ingressFrom := make([]interface{}, 0, len(ingressPeers))
+	for i, peer := range ingressPeers {
+		peerMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&peer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert networkPolicy.ingressFrom[%d]: %w", i, err)
+		}
+		ingressFrom = append(ingressFrom, peerMap)
+	}
 	values["networkPolicy"] = map[string]interface{}{
 		"egressRules":           egressRules,
 		"additionalEgressRules": additionalEgressRules,
+		"ingressFrom":           ingressFrom,
+	}
+
+	// Client token - disabled unless explicitly configured in the CR. Rotation
+	// is handled by the reconciler, which deletes expired token Secrets.
+	values["clientToken"] = map[string]interface{}{
+		"enabled": mlflow.Spec.ClientToken != nil,
+		"serviceAccount": map[string]interface{}{
+			"name": ClientServiceAccountName,
+		},
+		"secretName": ClientTokenSecretName,
 	}
 
 	// Garbage collection - disabled unless explicitly configured in the CR
@@ -687,28 +1848,20 @@ func (h *HelmRenderer) mlflowToHelmValues(
 }
 
 func buildMigrationNetworkPolicy(mlflow *mlflowv1.MLflow, namespace string) *networkingv1.NetworkPolicy {
-	udp := corev1.ProtocolUDP
 	tcp := corev1.ProtocolTCP
-	port53 := intstr.FromInt32(53)
-	port5353 := intstr.FromInt32(5353)
 	port5432 := intstr.FromInt32(5432)
 	port3306 := intstr.FromInt32(3306)
 
-	egressRules := []networkingv1.NetworkPolicyEgressRule{
-		{
-			Ports: []networkingv1.NetworkPolicyPort{
-				{Protocol: &udp, Port: &port53},
-				{Protocol: &tcp, Port: &port53},
-				{Protocol: &udp, Port: &port5353},
-				{Protocol: &tcp, Port: &port5353},
-			},
-		},
-		{
+	egressRules := []networkingv1.NetworkPolicyEgressRule{dnsEgressRule()}
+	if restrictEgress(mlflow) {
+		egressRules = append(egressRules, endpointEgressRules(databaseEgressEndpoints(mlflow))...)
+	} else {
+		egressRules = append(egressRules, networkingv1.NetworkPolicyEgressRule{
 			Ports: []networkingv1.NetworkPolicyPort{
 				{Protocol: &tcp, Port: &port5432},
 				{Protocol: &tcp, Port: &port3306},
 			},
-		},
+		})
 	}
 	if len(mlflow.Spec.NetworkPolicyAdditionalEgressRules) > 0 {
 		egressRules = append(egressRules, mlflow.Spec.NetworkPolicyAdditionalEgressRules...)
@@ -763,9 +1916,18 @@ func (h *HelmRenderer) renderTemplates(c *chart.Chart, values map[string]interfa
 		return nil, fmt.Errorf("failed to render templates: %w", err)
 	}
 
+	// Walk templates in name order so repeated renders of the same spec produce
+	// the same object order and byte-identical manifests.
+	templateNames := make([]string, 0, len(renderedTemplates))
+	for name := range renderedTemplates {
+		templateNames = append(templateNames, name)
+	}
+	sort.Strings(templateNames)
+
 	// Parse rendered YAML into unstructured objects
 	var objects []*unstructured.Unstructured
-	for name, content := range renderedTemplates {
+	for _, name := range templateNames {
+		content := renderedTemplates[name]
 		// Skip empty files and notes
 		if len(content) == 0 || filepath.Base(name) == "NOTES.txt" {
 			continue
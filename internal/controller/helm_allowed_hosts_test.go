@@ -0,0 +1,216 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func TestBuildAllowedHosts(t *testing.T) {
+	alwaysAllowed := []string{"localhost", "localhost:*", "127.0.0.1", "127.0.0.1:*", "$(POD_IP)", "$(POD_IP):*"}
+
+	tests := []struct {
+		name           string
+		mlflow         *mlflowv1.MLflow
+		mlflowURL      string
+		observedHosts  []string
+		wantContains   []string
+		wantNotContain []string
+	}{
+		{
+			name:      "default lists service and gateway names without a wildcard",
+			mlflow:    &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}},
+			mlflowURL: "https://gateway.example.com/mlflow",
+			wantContains: []string{
+				"mlflow",
+				"mlflow:*",
+				"mlflow.opendatahub.svc",
+				"mlflow.opendatahub.svc:*",
+				"mlflow.opendatahub.svc.cluster.local:*",
+				"gateway.example.com",
+				"gateway.example.com:*",
+			},
+			wantNotContain: []string{"*"},
+		},
+		{
+			name:         "default uses the instance resource suffix",
+			mlflow:       &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+			wantContains: []string{"mlflow-team-a.opendatahub.svc"},
+		},
+		{
+			name: "default includes an explicit OpenShift Route host",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					OpenShift: &mlflowv1.OpenShiftSpec{Route: &mlflowv1.RouteSpec{
+						Enabled: ptr(true),
+						Host:    ptr("mlflow.apps.example.com"),
+					}},
+				},
+			},
+			wantContains: []string{"mlflow.apps.example.com", "mlflow.apps.example.com:*"},
+		},
+		{
+			name: "default includes an explicit Ingress host",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					Ingress: &mlflowv1.IngressConfig{
+						Enabled: ptr(true),
+						Host:    ptr("mlflow.example.com"),
+					},
+				},
+			},
+			wantContains: []string{"mlflow.example.com", "mlflow.example.com:*"},
+		},
+		{
+			name: "default includes the requested load balancer IP",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					Service: &mlflowv1.ServiceSpec{
+						Type:           ptr(corev1.ServiceTypeLoadBalancer),
+						LoadBalancerIP: ptr("2001:db8::10"),
+					},
+				},
+			},
+			wantContains: []string{"[2001:db8::10]", "[2001:db8::10]:*"},
+		},
+		{
+			name: "default includes the stable pod DNS name",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					Hostname:  ptr("tracking"),
+					Subdomain: ptr("mlflow-headless"),
+				},
+			},
+			wantContains: []string{
+				"tracking.mlflow-headless.opendatahub.svc",
+				"tracking.mlflow-headless.opendatahub.svc.cluster.local:*",
+			},
+		},
+		{
+			name: "override replaces service and gateway names",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					AllowedHosts: []string{"mlflow.example.com", "mlflow.example.com:*"},
+				},
+			},
+			mlflowURL:      "https://gateway.example.com",
+			wantContains:   []string{"mlflow.example.com", "mlflow.example.com:*"},
+			wantNotContain: []string{"*", "mlflow", "mlflow.opendatahub.svc", "gateway.example.com"},
+		},
+		{
+			name:          "default includes hosts assigned by the cluster",
+			mlflow:        &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}},
+			observedHosts: []string{"mlflow-opendatahub.apps.example.com", "203.0.113.7", "2001:db8::20"},
+			wantContains: []string{
+				"mlflow-opendatahub.apps.example.com",
+				"mlflow-opendatahub.apps.example.com:*",
+				"203.0.113.7:*",
+				"[2001:db8::20]",
+			},
+		},
+		{
+			name: "extra hosts are added to the defaults",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					ExtraAllowedHosts: []string{"192.0.2.10:30443"},
+				},
+			},
+			wantContains: []string{"mlflow.opendatahub.svc", "192.0.2.10:30443"},
+		},
+		{
+			name: "extra hosts are added to an override, which drops observed hosts",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					AllowedHosts:      []string{"mlflow.example.com"},
+					ExtraAllowedHosts: []string{"192.0.2.10:30443"},
+				},
+			},
+			observedHosts:  []string{"mlflow-opendatahub.apps.example.com"},
+			wantContains:   []string{"mlflow.example.com", "192.0.2.10:30443"},
+			wantNotContain: []string{"mlflow.opendatahub.svc", "mlflow-opendatahub.apps.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			hosts := buildAllowedHosts(tt.mlflow, "opendatahub", &config.OperatorConfig{MLflowURL: tt.mlflowURL}, tt.observedHosts)
+
+			g.Expect(hosts).To(gomega.ContainElements(alwaysAllowed))
+			g.Expect(hosts).To(gomega.ContainElements(tt.wantContains))
+			for _, notWant := range tt.wantNotContain {
+				g.Expect(hosts).NotTo(gomega.ContainElement(notWant))
+			}
+		})
+	}
+}
+
+func TestRenderChart_AllowedHostsArg(t *testing.T) {
+	g := gomega.NewWithT(t)
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			AllowedHosts:    []string{"mlflow.example.com"},
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	container := deployment.Spec.Template.Spec.Containers[0]
+
+	args := container.Args
+	index := -1
+	for i, arg := range args {
+		if arg == "--allowed-hosts" {
+			index = i
+		}
+	}
+	g.Expect(index).To(gomega.BeNumerically(">=", 0), "--allowed-hosts not found in %v", args)
+	g.Expect(index + 1).To(gomega.BeNumerically("<", len(args)))
+	g.Expect(strings.Split(args[index+1], ",")).To(gomega.Equal([]string{
+		"mlflow.example.com",
+		"localhost", "localhost:*",
+		"127.0.0.1", "127.0.0.1:*",
+		"$(POD_IP)", "$(POD_IP):*",
+	}))
+
+	// $(POD_IP) in the args is expanded by the kubelet from this env var.
+	var podIPFieldPath string
+	for _, env := range container.Env {
+		if env.Name == "POD_IP" && env.ValueFrom != nil && env.ValueFrom.FieldRef != nil {
+			podIPFieldPath = env.ValueFrom.FieldRef.FieldPath
+		}
+	}
+	g.Expect(podIPFieldPath).To(gomega.Equal("status.podIP"))
+}
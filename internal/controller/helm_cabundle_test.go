@@ -19,8 +19,11 @@ package controller
 import (
 	"testing"
 
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 )
@@ -381,3 +384,145 @@ func TestRenderChart_NoCABundle(t *testing.T) {
 		}
 	}
 }
+
+// TestRenderChart_CABundleArtifactClients checks that every workload that talks to
+// the artifact store trusts the combined CA bundle, so HTTPS S3/MinIO endpoints
+// signed by a private CA work for the server, the CronJobs and the migration Job.
+func TestRenderChart_CABundleArtifactClients(t *testing.T) {
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:      ptr(testBackendStoreURI),
+			ServeArtifacts:       ptr(true),
+			ArtifactsDestination: ptr("s3://bucket/artifacts"),
+			CABundleConfigMap:    &mlflowv1.CABundleConfigMapSpec{Name: "minio-ca"},
+			GarbageCollection:    &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"},
+			TraceArchival: &mlflowv1.TraceArchivalSpec{
+				Enabled:  true,
+				Schedule: ptr("*/5 * * * *"),
+			},
+		},
+	}
+
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	if err != nil {
+		t.Fatalf("RenderChart() error = %v", err)
+	}
+
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	if err != nil {
+		t.Fatalf("renderedDeployment() error = %v", err)
+	}
+	job, err := buildMigrationJobFromDeployment(mlflow, deployment, "test-ns")
+	if err != nil {
+		t.Fatalf("buildMigrationJobFromDeployment() error = %v", err)
+	}
+
+	envByWorkload := map[string][]corev1.EnvVar{
+		"Deployment":    deployment.Spec.Template.Spec.Containers[0].Env,
+		"migration Job": job.Spec.Template.Spec.Containers[0].Env,
+	}
+	for _, name := range []string{"mlflow-gc", "mlflow-trace-archival"} {
+		obj := findObject(objs, "CronJob", name)
+		if obj == nil {
+			t.Fatalf("CronJob %s not found", name)
+		}
+		var cronJob batchv1.CronJob
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &cronJob); err != nil {
+			t.Fatalf("failed to convert CronJob %s: %v", name, err)
+		}
+		envByWorkload["CronJob "+name] = cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env
+	}
+
+	for workload, env := range envByWorkload {
+		found := map[string]string{}
+		for _, e := range env {
+			found[e.Name] = e.Value
+		}
+		for _, name := range []string{"AWS_CA_BUNDLE", "REQUESTS_CA_BUNDLE"} {
+			if got := found[name]; got != caCombinedBundle {
+				t.Errorf("%s: %s = %q, want %q", workload, name, got, caCombinedBundle)
+			}
+		}
+	}
+}
+
+func TestRenderChart_ArtifactStoreCABundleSecret(t *testing.T) {
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:      ptr(testBackendStoreURI),
+			ServeArtifacts:       ptr(true),
+			ArtifactsDestination: ptr("s3://bucket/artifacts"),
+			ArtifactStore:        &mlflowv1.ArtifactStoreSpec{CABundleSecret: ptr("s3-internal-ca")},
+		},
+	}
+
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	if err != nil {
+		t.Fatalf("RenderChart() error = %v", err)
+	}
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	if err != nil {
+		t.Fatalf("renderedDeployment() error = %v", err)
+	}
+	podSpec := deployment.Spec.Template.Spec
+
+	var caVolume *corev1.Volume
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Secret != nil && podSpec.Volumes[i].Secret.SecretName == "s3-internal-ca" {
+			caVolume = &podSpec.Volumes[i]
+		}
+	}
+	if caVolume == nil {
+		t.Fatalf("no Secret volume for s3-internal-ca in %v", podSpec.Volumes)
+	}
+	if caVolume.Name != "ca-bundle-0" {
+		t.Errorf("CA Secret volume name = %q, want ca-bundle-0", caVolume.Name)
+	}
+
+	// The Secret is combined into the shared bundle by the init container and
+	// kept up to date by the watcher sidecar.
+	for _, container := range []*corev1.Container{
+		findContainer(podSpec.InitContainers, "combine-ca-bundles"),
+		findContainer(podSpec.Containers, "ca-bundle-watcher"),
+	} {
+		if container == nil {
+			t.Fatal("CA bundle container not rendered")
+		}
+		mounted := false
+		for _, mount := range container.VolumeMounts {
+			if mount.Name == caVolume.Name && mount.MountPath == caArtifactStoreMount {
+				mounted = true
+			}
+		}
+		if !mounted {
+			t.Errorf("%s does not mount %s at %s", container.Name, caVolume.Name, caArtifactStoreMount)
+		}
+		for _, env := range container.Env {
+			if env.Name == "CA_BUNDLE_MOUNT_PATHS" && env.Value != caArtifactStoreMount {
+				t.Errorf("%s: CA_BUNDLE_MOUNT_PATHS = %q, want %q", container.Name, env.Value, caArtifactStoreMount)
+			}
+		}
+	}
+
+	mlflowContainer := findContainer(podSpec.Containers, "mlflow")
+	if mlflowContainer == nil {
+		t.Fatal("mlflow container not rendered")
+	}
+	found := map[string]string{}
+	for _, env := range mlflowContainer.Env {
+		found[env.Name] = env.Value
+	}
+	for _, name := range []string{"AWS_CA_BUNDLE", "REQUESTS_CA_BUNDLE"} {
+		if got := found[name]; got != caCombinedBundle {
+			t.Errorf("mlflow container: %s = %q, want %q", name, got, caCombinedBundle)
+		}
+	}
+	// An artifact store CA alone must not force TLS on the database clients.
+	for _, name := range []string{"PGSSLMODE", "PGSSLROOTCERT", "MLFLOW_MYSQL_CA"} {
+		if got, ok := found[name]; ok {
+			t.Errorf("mlflow container: %s = %q, want unset", name, got)
+		}
+	}
+}
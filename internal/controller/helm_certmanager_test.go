@@ -0,0 +1,182 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	gomega "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestRenderChart_CertManagerCertificate(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	// Without cert-manager the service-ca annotation provisions the Secret
+	objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "Certificate", "mlflow")).To(gomega.BeNil())
+	service := findObject(objs, "Service", "mlflow")
+	g.Expect(service).NotTo(gomega.BeNil())
+	g.Expect(service.GetAnnotations()).To(gomega.HaveKeyWithValue("service.beta.openshift.io/serving-cert-secret-name", TLSSecretName))
+
+	objs, err = renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			TLS: &mlflowv1.TLSSpec{
+				CertManager: &mlflowv1.CertManagerConfig{
+					Enabled:   ptr(true),
+					IssuerRef: &mlflowv1.CertManagerIssuerReference{Name: "cluster-ca", Kind: "ClusterIssuer"},
+					Duration:  &metav1.Duration{Duration: 2160 * time.Hour},
+				},
+			},
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	certificate := findObject(objs, "Certificate", "mlflow-dev")
+	g.Expect(certificate).NotTo(gomega.BeNil(), "Certificate should be rendered when certManager is enabled")
+	g.Expect(certificate.GetAPIVersion()).To(gomega.Equal("cert-manager.io/v1"))
+	g.Expect(certificate.GetNamespace()).To(gomega.Equal("test-ns"))
+
+	secretName, _, err := unstructured.NestedString(certificate.Object, "spec", "secretName")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(secretName).To(gomega.Equal(TLSSecretName), "Certificate should populate the Secret the MLflow container mounts")
+
+	dnsNames, _, err := unstructured.NestedStringSlice(certificate.Object, "spec", "dnsNames")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(dnsNames).To(gomega.ConsistOf(
+		"mlflow-dev",
+		"mlflow-dev.test-ns",
+		"mlflow-dev.test-ns.svc",
+		"mlflow-dev.test-ns.svc.cluster.local",
+	))
+
+	duration, _, err := unstructured.NestedString(certificate.Object, "spec", "duration")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(duration).To(gomega.Equal("2160h0m0s"))
+
+	issuerRef, _, err := unstructured.NestedStringMap(certificate.Object, "spec", "issuerRef")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(issuerRef).To(gomega.Equal(map[string]string{
+		"name":  "cluster-ca",
+		"kind":  "ClusterIssuer",
+		"group": "cert-manager.io",
+	}))
+
+	service = findObject(objs, "Service", "mlflow-dev")
+	g.Expect(service).NotTo(gomega.BeNil())
+	g.Expect(service.GetAnnotations()).NotTo(gomega.HaveKey("service.beta.openshift.io/serving-cert-secret-name"),
+		"service-ca must not compete with cert-manager for the Secret")
+}
+
+func TestRenderChart_CertManagerUpstreamCA(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:   ptr(testBackendStoreURI),
+			GarbageCollection: &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * *"},
+			TLS: &mlflowv1.TLSSpec{
+				CertManager: &mlflowv1.CertManagerConfig{
+					Enabled:   ptr(true),
+					IssuerRef: &mlflowv1.CertManagerIssuerReference{Name: "mlflow-ca"},
+				},
+			},
+		},
+	}, "test-ns", RenderOptions{ServiceMonitorAvailable: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	certificate := findObject(objs, "Certificate", "mlflow")
+	g.Expect(certificate).NotTo(gomega.BeNil())
+	issuerKind, _, err := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "kind")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(issuerKind).To(gomega.Equal("Issuer"), "issuer kind should default to Issuer")
+
+	// Prometheus verifies the server against the cert-manager CA instead of skipping verification
+	serviceMonitor := findObject(objs, "ServiceMonitor", "mlflow-metrics-monitor")
+	g.Expect(serviceMonitor).NotTo(gomega.BeNil())
+	endpoints, _, err := unstructured.NestedSlice(serviceMonitor.Object, "spec", "endpoints")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(endpoints).To(gomega.HaveLen(1))
+	tlsConfig := endpoints[0].(map[string]interface{})["tlsConfig"]
+	g.Expect(tlsConfig).To(gomega.Equal(map[string]interface{}{
+		"ca": map[string]interface{}{
+			"secret": map[string]interface{}{"name": TLSSecretName, "key": "ca.crt"},
+		},
+		"serverName": "mlflow.test-ns.svc",
+	}))
+
+	// The GC CronJob calls the tracking server and trusts the mounted CA file
+	cronJob := findObject(objs, "CronJob", "mlflow-gc")
+	g.Expect(cronJob).NotTo(gomega.BeNil())
+	var gcJob batchv1.CronJob
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(cronJob.Object, &gcJob)).To(gomega.Succeed())
+	podSpec := gcJob.Spec.JobTemplate.Spec.Template.Spec
+	gc := findContainer(podSpec.Containers, "mlflow-gc")
+	g.Expect(gc).NotTo(gomega.BeNil())
+	g.Expect(gc.Env).To(gomega.ContainElement(corev1.EnvVar{
+		Name:  "MLFLOW_TRACKING_SERVER_CERT_PATH",
+		Value: certManagerCAFile,
+	}))
+	g.Expect(gc.VolumeMounts).To(gomega.ContainElement(corev1.VolumeMount{
+		Name:      "mlflow-upstream-ca",
+		MountPath: "/etc/pki/tls/certs/mlflow-server",
+		ReadOnly:  true,
+	}))
+
+	var caVolume *corev1.Volume
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == "mlflow-upstream-ca" {
+			caVolume = &podSpec.Volumes[i]
+		}
+	}
+	g.Expect(caVolume).NotTo(gomega.BeNil())
+	g.Expect(caVolume.Secret).NotTo(gomega.BeNil())
+	g.Expect(caVolume.Secret.SecretName).To(gomega.Equal(TLSSecretName))
+	g.Expect(caVolume.Secret.Items).To(gomega.Equal([]corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}}))
+}
+
+func TestMlflowToHelmValues_CertManagerRequiresIssuer(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := &HelmRenderer{}
+
+	_, err := renderer.mlflowToHelmValues(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			TLS: &mlflowv1.TLSSpec{
+				CertManager: &mlflowv1.CertManagerConfig{Enabled: ptr(true)},
+			},
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("tls.certManager.issuerRef.name is required")))
+}
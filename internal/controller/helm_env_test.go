@@ -17,13 +17,18 @@ limitations under the License.
 package controller
 
 import (
+	"bytes"
+	"encoding/json"
+	"reflect"
 	"strings"
 	"testing"
 
 	gomega "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 )
@@ -62,6 +67,55 @@ func TestMlflowToHelmValues_StaticPrefix(t *testing.T) {
 	}
 }
 
+func TestRenderChart_BasePath(t *testing.T) {
+	tests := []struct {
+		name       string
+		basePath   *string
+		wantPrefix string
+	}{
+		{
+			name:       "default prefix",
+			wantPrefix: StaticPrefix,
+		},
+		{
+			name:       "base path sets every derived setting",
+			basePath:   ptr("/tracking/mlflow"),
+			wantPrefix: "/tracking/mlflow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:      ptr(testBackendStoreURI),
+					ServeArtifacts:       ptr(true),
+					ArtifactsDestination: ptr("s3://bucket/artifacts"),
+					BasePath:             tt.basePath,
+				},
+			}
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			container := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow")
+			g.Expect(container).NotTo(gomega.BeNil())
+			// The artifact proxy routes are mounted under the same prefix.
+			g.Expect(container.Args).To(gomega.ContainElements("--serve-artifacts", "--static-prefix="+tt.wantPrefix))
+			g.Expect(container.LivenessProbe.HTTPGet.Path).To(gomega.Equal(tt.wantPrefix + "/health"))
+			g.Expect(container.ReadinessProbe.HTTPGet.Path).To(gomega.Equal(tt.wantPrefix + "/api/3.0/mlflow/server-info"))
+
+			g.Expect(buildStatusAddress(mlflow.Name, "test-ns", mlflowBasePath(mlflow)).URL).
+				To(gomega.Equal("https://mlflow.test-ns.svc:8443" + tt.wantPrefix))
+			g.Expect(buildStatusURL(publicPathPrefix(mlflow), "https://gateway.example.com", true)).
+				To(gomega.Equal("https://gateway.example.com" + tt.wantPrefix))
+		})
+	}
+}
+
 func TestMlflowToHelmValues_Env(t *testing.T) {
 	renderer := &HelmRenderer{}
 
@@ -486,6 +540,66 @@ func TestRenderChart_EnvVars(t *testing.T) {
 	}
 }
 
+func TestRenderChart_AuthorizationMode(t *testing.T) {
+	tests := []struct {
+		name        string
+		auth        *mlflowv1.AuthConfig
+		wantMode    string
+		wantSARRule bool
+	}{
+		{
+			name:     "default uses self subject access reviews",
+			wantMode: "self_subject_access_review",
+		},
+		{
+			name:     "explicit self subject access review",
+			auth:     &mlflowv1.AuthConfig{AuthorizationMode: mlflowv1.MLflowAuthorizationSelfSubjectAccessReview},
+			wantMode: "self_subject_access_review",
+		},
+		{
+			name:        "subject access review grants the server SAR create",
+			auth:        &mlflowv1.AuthConfig{AuthorizationMode: mlflowv1.MLflowAuthorizationSubjectAccessReview},
+			wantMode:    "subject_access_review",
+			wantSARRule: true,
+		},
+	}
+
+	sarRule := rbacv1.PolicyRule{
+		APIGroups: []string{"authorization.k8s.io"},
+		Resources: []string{"subjectaccessreviews"},
+		Verbs:     []string{"create"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					Auth:            tt.auth,
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			container := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow")
+			g.Expect(container).NotTo(gomega.BeNil())
+			g.Expect(container.Env).To(gomega.ContainElement(corev1.EnvVar{Name: "MLFLOW_K8S_AUTH_AUTHORIZATION_MODE", Value: tt.wantMode}))
+
+			obj := findObject(objs, "ClusterRole", "mlflow")
+			g.Expect(obj).NotTo(gomega.BeNil())
+			clusterRole := &rbacv1.ClusterRole{}
+			g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, clusterRole)).To(gomega.Succeed())
+			if tt.wantSARRule {
+				g.Expect(clusterRole.Rules).To(gomega.ContainElement(sarRule))
+			} else {
+				g.Expect(clusterRole.Rules).NotTo(gomega.ContainElement(sarRule))
+			}
+		})
+	}
+}
+
 func TestRenderChart_WorkspaceLabelSelectorEnvVar(t *testing.T) {
 	renderer := NewHelmRenderer("../../charts/mlflow")
 
@@ -705,3 +819,756 @@ func TestRenderChart_WorkspaceLabelSelectorInvalidOperatorReturnsError(t *testin
 		t.Fatalf("error should mention workspaceLabelSelector, got: %v", err)
 	}
 }
+
+// TestRenderChart_StableEnvOrdering verifies that rendering the same spec twice
+// yields identical objects and env ordering so reconciles do not churn the Deployment.
+func TestRenderChart_StableEnvOrdering(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURIFrom: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"},
+				Key:                  "backend-store-uri",
+			},
+			RegistryStoreURIFrom: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"},
+				Key:                  "registry-store-uri",
+			},
+			Env: []corev1.EnvVar{
+				{Name: "ZETA", Value: "z"},
+				{Name: "ALPHA", Value: "a"},
+				{Name: "MIDDLE", Value: "m"},
+			},
+			PodLabels:      map[string]string{"b": "2", "a": "1", "c": "3"},
+			PodAnnotations: map[string]string{"y": "2", "x": "1"},
+			CABundleConfigMap: &mlflowv1.CABundleConfigMapSpec{
+				Name: "custom-ca",
+			},
+		},
+	}
+	opts := RenderOptions{IsOpenShift: true, PlatformTrustedCABundleExists: true}
+
+	render := func() ([]byte, []string) {
+		objs, err := renderer.RenderChart(mlflow, "test-ns", opts, nil)
+		if err != nil {
+			t.Fatalf("RenderChart() error = %v", err)
+		}
+		var order []string
+		for _, obj := range objs {
+			order = append(order, obj.GetKind()+"/"+obj.GetName())
+		}
+		deployment := findObject(objs, deploymentKind, "mlflow")
+		if deployment == nil {
+			t.Fatal("Deployment not found in rendered objects")
+		}
+		containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+		if err != nil {
+			t.Fatalf("failed to read containers: %v", err)
+		}
+		envs := make([]interface{}, 0, len(containers))
+		for _, c := range containers {
+			envs = append(envs, c.(map[string]interface{})["env"])
+		}
+		data, err := json.Marshal(envs)
+		if err != nil {
+			t.Fatalf("failed to marshal env: %v", err)
+		}
+		return data, order
+	}
+
+	firstEnv, firstOrder := render()
+	for i := 0; i < 5; i++ {
+		env, order := render()
+		if !bytes.Equal(firstEnv, env) {
+			t.Fatalf("env rendering differs between runs:\nfirst:  %s\nsecond: %s", firstEnv, env)
+		}
+		if !reflect.DeepEqual(firstOrder, order) {
+			t.Fatalf("object order differs between runs:\nfirst:  %v\nsecond: %v", firstOrder, order)
+		}
+	}
+
+	var containerEnvs [][]corev1.EnvVar
+	if err := json.Unmarshal(firstEnv, &containerEnvs); err != nil {
+		t.Fatalf("failed to unmarshal env: %v", err)
+	}
+	userEnv := []string{}
+	for _, e := range containerEnvs[0] {
+		if e.Name == "ZETA" || e.Name == "ALPHA" || e.Name == "MIDDLE" {
+			userEnv = append(userEnv, e.Name)
+		}
+	}
+	if !reflect.DeepEqual(userEnv, []string{"ZETA", "ALPHA", "MIDDLE"}) {
+		t.Errorf("user env order = %v, want spec order [ZETA ALPHA MIDDLE]", userEnv)
+	}
+}
+
+func TestRenderChart_ArtifactStoreForcePathStyle(t *testing.T) {
+	containerEnv := func(g *gomega.WithT, obj *unstructured.Unstructured, path ...string) map[string]interface{} {
+		g.Expect(obj).NotTo(gomega.BeNil())
+		containers, _, err := unstructured.NestedSlice(obj.Object, path...)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		envByName := map[string]interface{}{}
+		for _, e := range containers[0].(map[string]interface{})["env"].([]interface{}) {
+			env := e.(map[string]interface{})
+			envByName[env["name"].(string)] = env["value"]
+		}
+		return envByName
+	}
+
+	tests := []struct {
+		name           string
+		artifactStore  *mlflowv1.ArtifactStoreSpec
+		wantPathStyled bool
+	}{
+		{
+			name: "unset keeps default addressing",
+		},
+		{
+			name:          "false keeps default addressing",
+			artifactStore: &mlflowv1.ArtifactStoreSpec{S3: &mlflowv1.S3ArtifactStoreSpec{ForcePathStyle: ptr(false)}},
+		},
+		{
+			name:           "true forces path-style addressing",
+			artifactStore:  &mlflowv1.ArtifactStoreSpec{S3: &mlflowv1.S3ArtifactStoreSpec{ForcePathStyle: ptr(true)}},
+			wantPathStyled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:      ptr(testBackendStoreURI),
+					ServeArtifacts:       ptr(true),
+					ArtifactsDestination: ptr("s3://bucket/artifacts"),
+					ArtifactStore:        tt.artifactStore,
+					TraceArchival: &mlflowv1.TraceArchivalSpec{
+						Enabled:  true,
+						Schedule: ptr("*/5 * * * *"),
+					},
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			for _, env := range []map[string]interface{}{
+				containerEnv(g, findObject(objs, deploymentKind, "mlflow"), "spec", "template", "spec", "containers"),
+				containerEnv(g, findObject(objs, "CronJob", "mlflow-trace-archival"), "spec", "jobTemplate", "spec", "template", "spec", "containers"),
+			} {
+				if tt.wantPathStyled {
+					g.Expect(env).To(gomega.HaveKeyWithValue("MLFLOW_BOTO_CLIENT_ADDRESSING_STYLE", "path"))
+				} else {
+					g.Expect(env).NotTo(gomega.HaveKey("MLFLOW_BOTO_CLIENT_ADDRESSING_STYLE"))
+				}
+			}
+		})
+	}
+}
+
+func TestRenderChart_ArtifactStoreInsecureSkipVerify(t *testing.T) {
+	render := func(g *gomega.WithT, s3 *mlflowv1.S3ArtifactStoreSpec) []*unstructured.Unstructured {
+		var artifactStore *mlflowv1.ArtifactStoreSpec
+		if s3 != nil {
+			artifactStore = &mlflowv1.ArtifactStoreSpec{S3: s3}
+		}
+		objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			Spec: mlflowv1.MLflowSpec{
+				BackendStoreURI:      ptr(testBackendStoreURI),
+				ServeArtifacts:       ptr(true),
+				ArtifactsDestination: ptr("s3://bucket/artifacts"),
+				ArtifactStore:        artifactStore,
+			},
+		}, "test-ns", RenderOptions{}, nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		return objs
+	}
+	ignoreTLS := func(g *gomega.WithT, objs []*unstructured.Unstructured) (string, bool) {
+		deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		for _, env := range deployment.Spec.Template.Spec.Containers[0].Env {
+			if env.Name == "MLFLOW_S3_IGNORE_TLS" {
+				return env.Value, true
+			}
+		}
+		return "", false
+	}
+
+	g := gomega.NewWithT(t)
+	_, found := ignoreTLS(g, render(g, nil))
+	g.Expect(found).To(gomega.BeFalse(), "TLS verification must stay on by default")
+
+	_, found = ignoreTLS(g, render(g, &mlflowv1.S3ArtifactStoreSpec{InsecureSkipVerify: ptr(false)}))
+	g.Expect(found).To(gomega.BeFalse())
+
+	value, found := ignoreTLS(g, render(g, &mlflowv1.S3ArtifactStoreSpec{InsecureSkipVerify: ptr(true)}))
+	g.Expect(found).To(gomega.BeTrue())
+	g.Expect(value).To(gomega.Equal("true"))
+}
+
+func TestRenderChart_ArtifactStoreEndpointURL(t *testing.T) {
+	const minioEndpoint = "https://minio.storage.svc:9000"
+
+	tests := []struct {
+		name    string
+		s3      *mlflowv1.S3ArtifactStoreSpec
+		env     []corev1.EnvVar
+		wantEnv map[string]string
+		absent  []string
+	}{
+		{
+			name:   "unset renders no endpoint",
+			s3:     &mlflowv1.S3ArtifactStoreSpec{},
+			absent: []string{"MLFLOW_S3_ENDPOINT_URL", "MLFLOW_S3_IGNORE_TLS"},
+		},
+		{
+			name: "MinIO endpoint with path-style addressing and TLS verification disabled",
+			s3: &mlflowv1.S3ArtifactStoreSpec{
+				EndpointURL:        ptr(minioEndpoint),
+				ForcePathStyle:     ptr(true),
+				InsecureSkipVerify: ptr(true),
+			},
+			wantEnv: map[string]string{
+				"MLFLOW_S3_ENDPOINT_URL":              minioEndpoint,
+				"MLFLOW_BOTO_CLIENT_ADDRESSING_STYLE": "path",
+				"MLFLOW_S3_IGNORE_TLS":                "true",
+			},
+		},
+		{
+			name: "spec.env takes precedence over endpointURL",
+			s3:   &mlflowv1.S3ArtifactStoreSpec{EndpointURL: ptr(minioEndpoint)},
+			env: []corev1.EnvVar{
+				{Name: "MLFLOW_S3_ENDPOINT_URL", Value: "https://s3.internal.example.com"},
+				{Name: "MY_SETTING", Value: "kept"},
+			},
+			wantEnv: map[string]string{
+				"MLFLOW_S3_ENDPOINT_URL": "https://s3.internal.example.com",
+				"MY_SETTING":             "kept",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:      ptr(testBackendStoreURI),
+					ServeArtifacts:       ptr(true),
+					ArtifactsDestination: ptr("s3://bucket/artifacts"),
+					ArtifactStore:        &mlflowv1.ArtifactStoreSpec{S3: tt.s3},
+					Env:                  tt.env,
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment := findObject(objs, deploymentKind, "mlflow")
+			g.Expect(deployment).NotTo(gomega.BeNil())
+			containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			env := map[string]string{}
+			counts := map[string]int{}
+			for _, e := range containers[0].(map[string]interface{})["env"].([]interface{}) {
+				entry := e.(map[string]interface{})
+				name := entry["name"].(string)
+				value, _ := entry["value"].(string)
+				env[name] = value
+				counts[name]++
+			}
+			for name, want := range tt.wantEnv {
+				g.Expect(env).To(gomega.HaveKeyWithValue(name, want))
+				g.Expect(counts[name]).To(gomega.Equal(1), "%s should be rendered once", name)
+			}
+			for _, name := range tt.absent {
+				g.Expect(env).NotTo(gomega.HaveKey(name))
+			}
+		})
+	}
+}
+
+func TestRenderChart_ArtifactStoreAssumeRole(t *testing.T) {
+	const (
+		targetRole = "arn:aws:iam::111122223333:role/mlflow-artifacts"
+		sourceRole = "arn:aws:iam::444455556666:role/mlflow-irsa"
+	)
+
+	tests := []struct {
+		name        string
+		s3          *mlflowv1.S3ArtifactStoreSpec
+		wantErr     string
+		wantProfile []string
+		dontWant    []string
+	}{
+		{
+			name: "unset renders no profile",
+			s3:   &mlflowv1.S3ArtifactStoreSpec{},
+		},
+		{
+			name: "web identity assumes the target role directly",
+			s3:   &mlflowv1.S3ArtifactStoreSpec{AssumeRoleARN: ptr(targetRole)},
+			wantProfile: []string{
+				"role_arn = " + targetRole,
+				"web_identity_token_file = /var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+			},
+			dontWant: []string{"source_profile", "external_id"},
+		},
+		{
+			name: "source role chains into the target role with an external ID",
+			s3: &mlflowv1.S3ArtifactStoreSpec{
+				AssumeRoleARN: ptr(targetRole),
+				SourceRoleARN: ptr(sourceRole),
+				ExternalID:    ptr("tenant-42"),
+			},
+			wantProfile: []string{
+				"role_arn = " + targetRole,
+				"source_profile = mlflow-web-identity",
+				"external_id = tenant-42",
+				"[profile mlflow-web-identity]\nrole_arn = " + sourceRole,
+			},
+		},
+		{
+			name:    "external ID without a source role is rejected",
+			s3:      &mlflowv1.S3ArtifactStoreSpec{AssumeRoleARN: ptr(targetRole), ExternalID: ptr("tenant-42")},
+			wantErr: "externalID requires sourceRoleARN",
+		},
+		{
+			name:    "source role without a target role is rejected",
+			s3:      &mlflowv1.S3ArtifactStoreSpec{SourceRoleARN: ptr(sourceRole)},
+			wantErr: "sourceRoleARN requires assumeRoleARN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:      ptr(testBackendStoreURI),
+					ServeArtifacts:       ptr(true),
+					ArtifactsDestination: ptr("s3://bucket/artifacts"),
+					ArtifactStore:        &mlflowv1.ArtifactStoreSpec{S3: tt.s3},
+					TraceArchival: &mlflowv1.TraceArchivalSpec{
+						Enabled:  true,
+						Schedule: ptr("*/5 * * * *"),
+					},
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			configMap := findObject(objs, "ConfigMap", "mlflow-aws-config")
+			workloads := []struct {
+				obj  *unstructured.Unstructured
+				path []string
+			}{
+				{findObject(objs, deploymentKind, "mlflow"), []string{"spec", "template", "spec"}},
+				{findObject(objs, "CronJob", "mlflow-trace-archival"), []string{"spec", "jobTemplate", "spec", "template", "spec"}},
+			}
+			if tt.wantProfile == nil {
+				g.Expect(configMap).To(gomega.BeNil())
+			} else {
+				g.Expect(configMap).NotTo(gomega.BeNil())
+				profile, _, err := unstructured.NestedString(configMap.Object, "data", "config")
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				for _, want := range tt.wantProfile {
+					g.Expect(profile).To(gomega.ContainSubstring(want))
+				}
+				for _, unwanted := range tt.dontWant {
+					g.Expect(profile).NotTo(gomega.ContainSubstring(unwanted))
+				}
+			}
+
+			for _, w := range workloads {
+				g.Expect(w.obj).NotTo(gomega.BeNil())
+				podSpec, _, err := unstructured.NestedMap(w.obj.Object, w.path...)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				container := podSpec["containers"].([]interface{})[0].(map[string]interface{})
+				env := map[string]interface{}{}
+				for _, e := range container["env"].([]interface{}) {
+					env[e.(map[string]interface{})["name"].(string)] = e.(map[string]interface{})["value"]
+				}
+				mounts := map[string]interface{}{}
+				for _, m := range container["volumeMounts"].([]interface{}) {
+					mounts[m.(map[string]interface{})["name"].(string)] = m.(map[string]interface{})["mountPath"]
+				}
+				volumes := map[string]interface{}{}
+				for _, v := range podSpec["volumes"].([]interface{}) {
+					volumes[v.(map[string]interface{})["name"].(string)] = v
+				}
+
+				if tt.wantProfile == nil {
+					g.Expect(env).NotTo(gomega.HaveKey("AWS_PROFILE"))
+					g.Expect(volumes).NotTo(gomega.HaveKey("aws-config"))
+					continue
+				}
+				g.Expect(env).To(gomega.HaveKeyWithValue("AWS_CONFIG_FILE", "/etc/mlflow-aws/config"))
+				g.Expect(env).To(gomega.HaveKeyWithValue("AWS_PROFILE", "mlflow-artifacts"))
+				g.Expect(mounts).To(gomega.HaveKeyWithValue("aws-config", "/etc/mlflow-aws"))
+				g.Expect(volumes).To(gomega.HaveKey("aws-config"))
+			}
+		})
+	}
+}
+
+func TestRenderChart_ArtifactStoreCredentials(t *testing.T) {
+	secretKey := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "s3-credentials"},
+			Key:                  key,
+		}
+	}
+	staticKeys := mlflowv1.S3ArtifactStoreSpec{
+		AccessKeyIDSecret:     secretKey("access-key-id"),
+		SecretAccessKeySecret: secretKey("secret-access-key"),
+	}
+	withSessionToken := staticKeys
+	withSessionToken.SessionTokenSecret = secretKey("session-token")
+
+	tests := []struct {
+		name    string
+		s3      mlflowv1.S3ArtifactStoreSpec
+		wantErr string
+		wantEnv map[string]string
+	}{
+		{
+			name:    "unset renders no credentials",
+			wantEnv: map[string]string{},
+		},
+		{
+			name: "static access keys",
+			s3:   staticKeys,
+			wantEnv: map[string]string{
+				"AWS_ACCESS_KEY_ID":     "access-key-id",
+				"AWS_SECRET_ACCESS_KEY": "secret-access-key",
+			},
+		},
+		{
+			name: "temporary credentials add the session token",
+			s3:   withSessionToken,
+			wantEnv: map[string]string{
+				"AWS_ACCESS_KEY_ID":     "access-key-id",
+				"AWS_SECRET_ACCESS_KEY": "secret-access-key",
+				"AWS_SESSION_TOKEN":     "session-token",
+			},
+		},
+		{
+			name:    "session token without access keys is rejected",
+			s3:      mlflowv1.S3ArtifactStoreSpec{SessionTokenSecret: secretKey("session-token")},
+			wantErr: "sessionTokenSecret requires accessKeyIDSecret and secretAccessKeySecret",
+		},
+		{
+			name:    "access key ID without a secret access key is rejected",
+			s3:      mlflowv1.S3ArtifactStoreSpec{AccessKeyIDSecret: secretKey("access-key-id")},
+			wantErr: "accessKeyIDSecret and secretAccessKeySecret must be set together",
+		},
+		{
+			name: "access keys with an assumed role are rejected",
+			s3: mlflowv1.S3ArtifactStoreSpec{
+				AccessKeyIDSecret:     secretKey("access-key-id"),
+				SecretAccessKeySecret: secretKey("secret-access-key"),
+				AssumeRoleARN:         ptr("arn:aws:iam::111122223333:role/mlflow-artifacts"),
+			},
+			wantErr: "accessKeyIDSecret cannot be combined with assumeRoleARN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:      ptr(testBackendStoreURI),
+					ServeArtifacts:       ptr(true),
+					ArtifactsDestination: ptr("s3://bucket/artifacts"),
+					ArtifactStore:        &mlflowv1.ArtifactStoreSpec{S3: &tt.s3},
+					TraceArchival: &mlflowv1.TraceArchivalSpec{
+						Enabled:  true,
+						Schedule: ptr("*/5 * * * *"),
+					},
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			workloads := []struct {
+				obj  *unstructured.Unstructured
+				path []string
+			}{
+				{findObject(objs, deploymentKind, "mlflow"), []string{"spec", "template", "spec", "containers"}},
+				{findObject(objs, "CronJob", "mlflow-trace-archival"), []string{"spec", "jobTemplate", "spec", "template", "spec", "containers"}},
+			}
+			for _, w := range workloads {
+				g.Expect(w.obj).NotTo(gomega.BeNil())
+				containers, _, err := unstructured.NestedSlice(w.obj.Object, w.path...)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				container := containers[0].(map[string]interface{})
+				credentialKeys := map[string]string{}
+				for _, e := range container["env"].([]interface{}) {
+					env := e.(map[string]interface{})
+					name := env["name"].(string)
+					if !strings.HasPrefix(name, "AWS_") || name == "AWS_CONFIG_FILE" || name == "AWS_PROFILE" {
+						continue
+					}
+					secretKeyRef, _, err := unstructured.NestedStringMap(env, "valueFrom", "secretKeyRef")
+					g.Expect(err).NotTo(gomega.HaveOccurred())
+					g.Expect(secretKeyRef).To(gomega.HaveKeyWithValue("name", "s3-credentials"))
+					credentialKeys[name] = secretKeyRef["key"]
+				}
+				g.Expect(credentialKeys).To(gomega.Equal(tt.wantEnv))
+			}
+		})
+	}
+}
+
+func TestRenderChart_DatabaseConnection(t *testing.T) {
+	passwordRef := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "mlflow-db"},
+		Key:                  "password",
+	}
+
+	tests := []struct {
+		name         string
+		conn         *mlflowv1.DatabaseConnectionSpec
+		wantURI      string
+		wantPassword *corev1.SecretKeySelector
+	}{
+		{
+			name:    "defaults driver and port",
+			conn:    &mlflowv1.DatabaseConnectionSpec{Host: "db.example.com", Database: "mlflow", User: "mlflow"},
+			wantURI: "postgresql://mlflow@db.example.com:5432/mlflow",
+		},
+		{
+			name: "escapes reserved characters in the user",
+			conn: &mlflowv1.DatabaseConnectionSpec{
+				Driver:   "postgresql+psycopg2",
+				Host:     "10.0.0.5",
+				Port:     ptr(int32(6432)),
+				Database: "tracking",
+				User:     "svc@tenant:a/b",
+			},
+			wantURI: "postgresql+psycopg2://svc%40tenant%3Aa%2Fb@10.0.0.5:6432/tracking",
+		},
+		{
+			name:    "brackets IPv6 hosts",
+			conn:    &mlflowv1.DatabaseConnectionSpec{Host: "fd00::10", Database: "mlflow", User: "mlflow"},
+			wantURI: "postgresql://mlflow@[fd00::10]:5432/mlflow",
+		},
+		{
+			name: "passes the password through PGPASSWORD",
+			conn: &mlflowv1.DatabaseConnectionSpec{
+				Host:           "db.example.com",
+				Database:       "mlflow",
+				User:           "mlflow",
+				PasswordSecret: passwordRef,
+			},
+			wantURI:      "postgresql://mlflow@db.example.com:5432/mlflow",
+			wantPassword: passwordRef,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					Database: &mlflowv1.DatabaseSpec{Connection: tt.conn},
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			env := map[string]corev1.EnvVar{}
+			for _, e := range deployment.Spec.Template.Spec.Containers[0].Env {
+				env[e.Name] = e
+			}
+
+			g.Expect(env).To(gomega.HaveKey("MLFLOW_BACKEND_STORE_URI"))
+			g.Expect(env["MLFLOW_BACKEND_STORE_URI"].Value).To(gomega.Equal(tt.wantURI))
+			g.Expect(env).To(gomega.HaveKey("MLFLOW_REGISTRY_STORE_URI"))
+			g.Expect(env["MLFLOW_REGISTRY_STORE_URI"].Value).To(gomega.Equal(tt.wantURI))
+
+			if tt.wantPassword == nil {
+				g.Expect(env).NotTo(gomega.HaveKey("PGPASSWORD"))
+				return
+			}
+			g.Expect(env).To(gomega.HaveKey("PGPASSWORD"))
+			g.Expect(env["PGPASSWORD"].ValueFrom).NotTo(gomega.BeNil())
+			g.Expect(env["PGPASSWORD"].ValueFrom.SecretKeyRef).To(gomega.Equal(tt.wantPassword))
+		})
+	}
+}
+
+func TestRenderChart_DatabasePool(t *testing.T) {
+	pool := &mlflowv1.DatabaseSpec{
+		PoolSize:           ptr(int32(20)),
+		MaxOverflow:        ptr(int32(0)),
+		PoolRecycleSeconds: ptr(int32(1800)),
+	}
+	poolEnvNames := []string{
+		"MLFLOW_SQLALCHEMYSTORE_POOL_SIZE",
+		"MLFLOW_SQLALCHEMYSTORE_MAX_OVERFLOW",
+		"MLFLOW_SQLALCHEMYSTORE_POOL_RECYCLE",
+	}
+
+	tests := []struct {
+		name            string
+		backendStoreURI string
+		env             []corev1.EnvVar
+		wantEnv         map[string]string
+	}{
+		{
+			name:            "postgres backend renders the pool settings",
+			backendStoreURI: testBackendStoreURI,
+			wantEnv: map[string]string{
+				"MLFLOW_SQLALCHEMYSTORE_POOL_SIZE":    "20",
+				"MLFLOW_SQLALCHEMYSTORE_MAX_OVERFLOW": "0",
+				"MLFLOW_SQLALCHEMYSTORE_POOL_RECYCLE": "1800",
+			},
+		},
+		{
+			name:            "spec.env takes precedence",
+			backendStoreURI: testBackendStoreURI,
+			env:             []corev1.EnvVar{{Name: "MLFLOW_SQLALCHEMYSTORE_POOL_SIZE", Value: "50"}},
+			wantEnv: map[string]string{
+				"MLFLOW_SQLALCHEMYSTORE_POOL_SIZE":    "50",
+				"MLFLOW_SQLALCHEMYSTORE_MAX_OVERFLOW": "0",
+				"MLFLOW_SQLALCHEMYSTORE_POOL_RECYCLE": "1800",
+			},
+		},
+		{
+			name:            "sqlite backend ignores the pool settings",
+			backendStoreURI: "sqlite:////mlflow/mlflow.db",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(tt.backendStoreURI),
+					Database:        pool,
+					Env:             tt.env,
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			env := map[string]string{}
+			counts := map[string]int{}
+			for _, e := range deployment.Spec.Template.Spec.Containers[0].Env {
+				env[e.Name] = e.Value
+				counts[e.Name]++
+			}
+			if tt.wantEnv == nil {
+				for _, name := range poolEnvNames {
+					g.Expect(env).NotTo(gomega.HaveKey(name))
+				}
+				return
+			}
+			for name, want := range tt.wantEnv {
+				g.Expect(env).To(gomega.HaveKeyWithValue(name, want))
+				g.Expect(counts[name]).To(gomega.Equal(1), "%s should be rendered once", name)
+			}
+		})
+	}
+}
+
+func TestRenderChart_StoreURIsFromSecrets(t *testing.T) {
+	g := gomega.NewWithT(t)
+	selector := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mlflow-stores"},
+			Key:                  key,
+		}
+	}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURIFrom:      selector("backend-store-uri"),
+			RegistryStoreURIFrom:     selector("registry-store-uri"),
+			ArtifactsDestinationFrom: selector("artifacts-destination"),
+			ServeArtifacts:           ptr(true),
+		},
+	}
+
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	container := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow")
+	g.Expect(container).NotTo(gomega.BeNil())
+
+	for name, key := range map[string]string{
+		"MLFLOW_BACKEND_STORE_URI":     "backend-store-uri",
+		"MLFLOW_REGISTRY_STORE_URI":    "registry-store-uri",
+		"MLFLOW_ARTIFACTS_DESTINATION": "artifacts-destination",
+	} {
+		g.Expect(container.Env).To(gomega.ContainElement(corev1.EnvVar{
+			Name:      name,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: selector(key)},
+		}), name)
+	}
+	g.Expect(container.Args).To(gomega.ContainElement("--artifacts-destination=$(MLFLOW_ARTIFACTS_DESTINATION)"))
+	g.Expect(container.Args).NotTo(gomega.ContainElement("--artifacts-destination=" + defaultArtifactsDest))
+	g.Expect(referencedSecretNames(mlflow).UnsortedList()).To(gomega.ConsistOf("mlflow-stores"))
+}
+
+func TestMlflowToHelmValues_ArtifactsDestinationFromErrors(t *testing.T) {
+	from := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "mlflow-stores"},
+		Key:                  "artifacts-destination",
+	}
+	tests := []struct {
+		name    string
+		spec    mlflowv1.MLflowSpec
+		wantErr string
+	}{
+		{
+			name: "literal and secret reference",
+			spec: mlflowv1.MLflowSpec{
+				ArtifactsDestination:     ptr("s3://bucket/artifacts"),
+				ArtifactsDestinationFrom: from,
+			},
+			wantErr: "artifactsDestination and artifactsDestinationFrom are mutually exclusive",
+		},
+		{
+			name: "with artifactsSubPath",
+			spec: mlflowv1.MLflowSpec{
+				ArtifactsDestinationFrom: from,
+				ArtifactsSubPath:         ptr("artifacts"),
+				Storage:                  &corev1.PersistentVolumeClaimSpec{},
+			},
+			wantErr: "artifactsSubPath cannot be combined with artifactsDestinationFrom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.spec.BackendStoreURI = ptr(testBackendStoreURI)
+			tt.spec.ServeArtifacts = ptr(true)
+			_, err := (&HelmRenderer{}).mlflowToHelmValues(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec:       tt.spec,
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+		})
+	}
+}
@@ -445,6 +445,79 @@ func TestRenderChart_GarbageCollection(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "gc with S3 artifact store - CronJob gets the artifact store client settings",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:      ptr(testBackendStoreURI),
+					ArtifactsDestination: ptr("s3://bucket/artifacts"),
+					ArtifactStore: &mlflowv1.ArtifactStoreSpec{S3: &mlflowv1.S3ArtifactStoreSpec{
+						EndpointURL:   ptr("https://minio.example.com"),
+						AssumeRoleARN: ptr("arn:aws:iam::111122223333:role/mlflow-artifacts"),
+					}},
+					GarbageCollection: &mlflowv1.GarbageCollectionSpec{
+						Schedule: "0 2 * * 0",
+					},
+				},
+			},
+			namespace: "test-ns",
+			validateObjs: func(t *testing.T, objs []*unstructured.Unstructured) {
+				cronJob := findObject(objs, "CronJob", "mlflow-gc")
+				if cronJob == nil {
+					t.Fatal("CronJob not found in rendered objects")
+				}
+
+				volumes, _, _ := unstructured.NestedSlice(cronJob.Object,
+					"spec", "jobTemplate", "spec", "template", "spec", "volumes")
+				hasAWSConfigVolume := false
+				for _, v := range volumes {
+					vol := v.(map[string]interface{})
+					if vol["name"] == "aws-config" {
+						hasAWSConfigVolume = true
+					}
+				}
+				if !hasAWSConfigVolume {
+					t.Error("aws-config volume not found in CronJob")
+				}
+
+				containers, found, err := unstructured.NestedSlice(cronJob.Object,
+					"spec", "jobTemplate", "spec", "template", "spec", "containers")
+				if err != nil || !found || len(containers) == 0 {
+					t.Fatalf("Failed to get containers: found=%v, err=%v", found, err)
+				}
+				container := containers[0].(map[string]interface{})
+
+				envVars, _, _ := unstructured.NestedSlice(container, "env")
+				env := map[string]interface{}{}
+				for _, e := range envVars {
+					envVar := e.(map[string]interface{})
+					env[envVar["name"].(string)] = envVar["value"]
+				}
+				wantEnv := map[string]string{
+					"MLFLOW_S3_ENDPOINT_URL": "https://minio.example.com",
+					"AWS_CONFIG_FILE":        "/etc/mlflow-aws/config",
+					"AWS_PROFILE":            "mlflow-artifacts",
+				}
+				for name, want := range wantEnv {
+					if env[name] != want {
+						t.Errorf("CronJob env %s = %v, want %s", name, env[name], want)
+					}
+				}
+
+				mounts, _, _ := unstructured.NestedSlice(container, "volumeMounts")
+				hasAWSConfigMount := false
+				for _, m := range mounts {
+					mount := m.(map[string]interface{})
+					if mount["name"] == "aws-config" && mount["mountPath"] == "/etc/mlflow-aws" {
+						hasAWSConfigMount = true
+					}
+				}
+				if !hasAWSConfigMount {
+					t.Error("/etc/mlflow-aws volume mount not found in CronJob")
+				}
+			},
+		},
 		{
 			name: "gc with resource suffix - CronJob name includes suffix",
 			mlflow: &mlflowv1.MLflow{
@@ -92,3 +92,121 @@ func TestMlflowToHelmValues_Image(t *testing.T) {
 		})
 	}
 }
+
+func TestMlflowImageReference(t *testing.T) {
+	tests := []struct {
+		name         string
+		defaultImage string
+		image        *mlflowv1.ImageConfig
+		want         string
+		wantErr      string
+	}{
+		{
+			name:         "no image config uses the default",
+			defaultImage: "quay.io/opendatahub/mlflow:3.1",
+			want:         "quay.io/opendatahub/mlflow:3.1",
+		},
+		{
+			name:         "combined image wins over the default",
+			defaultImage: "quay.io/opendatahub/mlflow:3.1",
+			image:        &mlflowv1.ImageConfig{Image: ptr("custom/mlflow:v2.0.0")},
+			want:         "custom/mlflow:v2.0.0",
+		},
+		{
+			name:         "repository and tag take precedence over the default",
+			defaultImage: "quay.io/opendatahub/mlflow:3.1",
+			image:        &mlflowv1.ImageConfig{Repository: ptr("registry.example.com:5000/mirror/mlflow"), Tag: ptr("3.2-rc1")},
+			want:         "registry.example.com:5000/mirror/mlflow:3.2-rc1",
+		},
+		{
+			name:         "tag alone keeps the default repository",
+			defaultImage: "registry.example.com:5000/opendatahub/mlflow:3.1",
+			image:        &mlflowv1.ImageConfig{Tag: ptr("3.2")},
+			want:         "registry.example.com:5000/opendatahub/mlflow:3.2",
+		},
+		{
+			name:         "tag alone replaces a default digest",
+			defaultImage: "quay.io/opendatahub/mlflow@sha256:0123abcd",
+			image:        &mlflowv1.ImageConfig{Tag: ptr("3.2")},
+			want:         "quay.io/opendatahub/mlflow:3.2",
+		},
+		{
+			name:         "repository alone keeps the default digest",
+			defaultImage: "quay.io/opendatahub/mlflow@sha256:0123abcd",
+			image:        &mlflowv1.ImageConfig{Repository: ptr("mirror.example.com/mlflow")},
+			want:         "mirror.example.com/mlflow@sha256:0123abcd",
+		},
+		{
+			name:         "repository alone with an untagged default",
+			defaultImage: "localhost:5000/mlflow",
+			image:        &mlflowv1.ImageConfig{Repository: ptr("mirror.example.com/mlflow")},
+			want:         "mirror.example.com/mlflow",
+		},
+		{
+			name:         "tag alone replaces a default tag and digest",
+			defaultImage: "quay.io/opendatahub/mlflow:3.1@sha256:0123abcd",
+			image:        &mlflowv1.ImageConfig{Tag: ptr("3.2")},
+			want:         "quay.io/opendatahub/mlflow:3.2",
+		},
+		{
+			name:         "pull policy alone uses the default",
+			defaultImage: "quay.io/opendatahub/mlflow:3.1",
+			image:        &mlflowv1.ImageConfig{ImagePullPolicy: ptr(corev1.PullAlways)},
+			want:         "quay.io/opendatahub/mlflow:3.1",
+		},
+		{
+			name:         "image cannot be combined with tag",
+			defaultImage: "quay.io/opendatahub/mlflow:3.1",
+			image:        &mlflowv1.ImageConfig{Image: ptr("custom/mlflow:v2.0.0"), Tag: ptr("3.2")},
+			wantErr:      "spec.image.image cannot be combined with spec.image.repository or spec.image.tag",
+		},
+		{
+			name:         "image cannot be combined with repository",
+			defaultImage: "quay.io/opendatahub/mlflow:3.1",
+			image:        &mlflowv1.ImageConfig{Image: ptr("custom/mlflow:v2.0.0"), Repository: ptr("custom/mlflow")},
+			wantErr:      "spec.image.image cannot be combined with spec.image.repository or spec.image.tag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := mlflowImageReference(tt.defaultImage, tt.image)
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestSplitImage(t *testing.T) {
+	tests := []struct {
+		ref            string
+		wantRepository string
+		wantTag        string
+		wantDigest     string
+	}{
+		{ref: "mlflow", wantRepository: "mlflow"},
+		{ref: "quay.io/org/mlflow:v2.0.0", wantRepository: "quay.io/org/mlflow", wantTag: "v2.0.0"},
+		{ref: "quay.io/org/mlflow@sha256:0123abcd", wantRepository: "quay.io/org/mlflow", wantDigest: "sha256:0123abcd"},
+		{ref: "localhost:5000/mlflow", wantRepository: "localhost:5000/mlflow"},
+		{ref: "localhost:5000/mlflow:3.1", wantRepository: "localhost:5000/mlflow", wantTag: "3.1"},
+		{ref: "localhost:5000/mlflow@sha256:0123abcd", wantRepository: "localhost:5000/mlflow", wantDigest: "sha256:0123abcd"},
+		{ref: "quay.io/org/mlflow:v2.0.0@sha256:0123abcd", wantRepository: "quay.io/org/mlflow", wantTag: "v2.0.0", wantDigest: "sha256:0123abcd"},
+		{ref: "registry.example.com:5000/org/mlflow:v2.0.0@sha256:0123abcd", wantRepository: "registry.example.com:5000/org/mlflow", wantTag: "v2.0.0", wantDigest: "sha256:0123abcd"},
+		{ref: "mlflow:v2.0.0@sha256:0123abcd", wantRepository: "mlflow", wantTag: "v2.0.0", wantDigest: "sha256:0123abcd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			repository, tag, digest := splitImage(tt.ref)
+			g.Expect(repository).To(gomega.Equal(tt.wantRepository))
+			g.Expect(tag).To(gomega.Equal(tt.wantTag))
+			g.Expect(digest).To(gomega.Equal(tt.wantDigest))
+		})
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestIngressValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		ingress *mlflowv1.IngressConfig
+		want    map[string]interface{}
+	}{
+		{
+			name: "disabled by default",
+			want: map[string]interface{}{"enabled": false},
+		},
+		{
+			name:    "enabled ingress sets the HTTPS backend protocol",
+			ingress: &mlflowv1.IngressConfig{Enabled: ptr(true)},
+			want: map[string]interface{}{
+				"enabled":     true,
+				"annotations": map[string]interface{}{ingressBackendProtocolAnnotation: "HTTPS"},
+			},
+		},
+		{
+			name: "user annotations override the backend protocol",
+			ingress: &mlflowv1.IngressConfig{
+				Enabled:   ptr(true),
+				ClassName: ptr("nginx"),
+				Host:      ptr("mlflow.example.com"),
+				Annotations: map[string]string{
+					ingressBackendProtocolAnnotation: "GRPCS",
+					"cert-manager.io/cluster-issuer": "letsencrypt",
+				},
+				TLS: []networkingv1.IngressTLS{{Hosts: []string{"mlflow.example.com"}, SecretName: "mlflow-ingress-tls"}},
+			},
+			want: map[string]interface{}{
+				"enabled":   true,
+				"className": "nginx",
+				"host":      "mlflow.example.com",
+				"annotations": map[string]interface{}{
+					ingressBackendProtocolAnnotation: "GRPCS",
+					"cert-manager.io/cluster-issuer": "letsencrypt",
+				},
+				"tls": []interface{}{map[string]interface{}{
+					"hosts":      []interface{}{"mlflow.example.com"},
+					"secretName": "mlflow-ingress-tls",
+				}},
+			},
+		},
+		{
+			name: "streaming turns off proxy buffering",
+			ingress: &mlflowv1.IngressConfig{
+				Enabled:   ptr(true),
+				Streaming: ptr(true),
+			},
+			want: map[string]interface{}{
+				"enabled": true,
+				"annotations": map[string]interface{}{
+					ingressBackendProtocolAnnotation:  "HTTPS",
+					ingressProxyBufferingAnnotation:   "off",
+					ingressRequestBufferingAnnotation: "off",
+				},
+			},
+		},
+		{
+			name: "user annotations override the streaming defaults",
+			ingress: &mlflowv1.IngressConfig{
+				Enabled:     ptr(true),
+				Streaming:   ptr(true),
+				Annotations: map[string]string{ingressRequestBufferingAnnotation: "on"},
+			},
+			want: map[string]interface{}{
+				"enabled": true,
+				"annotations": map[string]interface{}{
+					ingressBackendProtocolAnnotation:  "HTTPS",
+					ingressProxyBufferingAnnotation:   "off",
+					ingressRequestBufferingAnnotation: "on",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got := ingressValues(&mlflowv1.MLflow{Spec: mlflowv1.MLflowSpec{Ingress: tt.ingress}})
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestRenderChart_Ingress(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+		},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "Ingress", "mlflow-team-a")).To(gomega.BeNil())
+
+	mlflow.Spec.Service = &mlflowv1.ServiceSpec{PortName: ptr("mlflow-https")}
+	mlflow.Spec.Ingress = &mlflowv1.IngressConfig{
+		Enabled:     ptr(true),
+		ClassName:   ptr("nginx"),
+		Host:        ptr("mlflow.example.com"),
+		Annotations: map[string]string{"cert-manager.io/cluster-issuer": "letsencrypt"},
+		TLS:         []networkingv1.IngressTLS{{Hosts: []string{"mlflow.example.com"}, SecretName: "mlflow-ingress-tls"}},
+	}
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	obj := findObject(objs, "Ingress", "mlflow-team-a")
+	g.Expect(obj).NotTo(gomega.BeNil())
+
+	var ingress networkingv1.Ingress
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &ingress)).To(gomega.Succeed())
+	g.Expect(ingress.Namespace).To(gomega.Equal("test-ns"))
+	g.Expect(ingress.Labels).To(gomega.HaveKeyWithValue("app", "mlflow-team-a"))
+	g.Expect(ingress.Annotations).To(gomega.Equal(map[string]string{
+		ingressBackendProtocolAnnotation: "HTTPS",
+		"cert-manager.io/cluster-issuer": "letsencrypt",
+	}))
+	g.Expect(ingress.Spec.IngressClassName).To(gomega.Equal(ptr("nginx")))
+	g.Expect(ingress.Spec.TLS).To(gomega.Equal(mlflow.Spec.Ingress.TLS))
+	g.Expect(ingress.Spec.Rules).To(gomega.HaveLen(1))
+	rule := ingress.Spec.Rules[0]
+	g.Expect(rule.Host).To(gomega.Equal("mlflow.example.com"))
+	g.Expect(rule.HTTP).NotTo(gomega.BeNil())
+	g.Expect(rule.HTTP.Paths).To(gomega.HaveLen(1))
+	path := rule.HTTP.Paths[0]
+	g.Expect(path.Path).To(gomega.Equal("/"))
+	g.Expect(path.PathType).To(gomega.Equal(ptr(networkingv1.PathTypePrefix)))
+	g.Expect(path.Backend.Service).NotTo(gomega.BeNil())
+	g.Expect(path.Backend.Service.Name).To(gomega.Equal("mlflow-team-a"))
+	g.Expect(path.Backend.Service.Port.Name).To(gomega.Equal("mlflow-https"))
+
+	// Without a host the rule matches any host.
+	mlflow.Spec.Ingress = &mlflowv1.IngressConfig{Enabled: ptr(true)}
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	obj = findObject(objs, "Ingress", "mlflow-team-a")
+	g.Expect(obj).NotTo(gomega.BeNil())
+	var anyHost networkingv1.Ingress
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &anyHost)).To(gomega.Succeed())
+	g.Expect(anyHost.Spec.IngressClassName).To(gomega.BeNil())
+	g.Expect(anyHost.Spec.Rules).To(gomega.HaveLen(1))
+	g.Expect(anyHost.Spec.Rules[0].Host).To(gomega.BeEmpty())
+}
@@ -20,8 +20,10 @@ import (
 	"testing"
 
 	gomega "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 )
@@ -289,3 +291,51 @@ func TestRenderChart_ServiceMonitorInsecureSkipVerify(t *testing.T) {
 	}
 	g.Expect(foundTLS).To(gomega.BeTrue(), "mlflow-tls volume should be present")
 }
+
+func TestRenderChart_ServiceMonitorRelabelings(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	serviceMonitorEndpoint := func(monitoring *mlflowv1.MonitoringSpec) map[string]interface{} {
+		objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			Spec: mlflowv1.MLflowSpec{
+				BackendStoreURI: ptr(testBackendStoreURI),
+				Monitoring:      monitoring,
+			},
+		}, "default", RenderOptions{ServiceMonitorAvailable: true}, nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		serviceMonitor := findObject(objs, "ServiceMonitor", "mlflow-metrics-monitor")
+		g.Expect(serviceMonitor).NotTo(gomega.BeNil())
+		endpoints, _, err := unstructured.NestedSlice(serviceMonitor.Object, "spec", "endpoints")
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(endpoints).To(gomega.HaveLen(1))
+		return endpoints[0].(map[string]interface{})
+	}
+
+	// Relabeling is empty by default.
+	endpoint := serviceMonitorEndpoint(nil)
+	g.Expect(endpoint).NotTo(gomega.HaveKey("relabelings"))
+	g.Expect(endpoint).NotTo(gomega.HaveKey("metricRelabelings"))
+
+	endpoint = serviceMonitorEndpoint(&mlflowv1.MonitoringSpec{
+		MetricRelabelings: []mlflowv1.RelabelConfig{
+			{Action: "labeldrop", Regex: "exception_type"},
+			{Action: "drop", SourceLabels: []string{"__name__"}, Regex: "http_request_size_bytes_.*"},
+		},
+		Relabelings: []mlflowv1.RelabelConfig{
+			{SourceLabels: []string{"__meta_kubernetes_pod_node_name"}, TargetLabel: "node", Replacement: ptr("$1")},
+		},
+	})
+
+	// The rendered endpoint must decode into the prometheus-operator type unchanged.
+	var decoded monitoringv1.Endpoint
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(endpoint, &decoded)).To(gomega.Succeed())
+	g.Expect(decoded.MetricRelabelConfigs).To(gomega.HaveLen(2))
+	g.Expect(decoded.MetricRelabelConfigs[0].Action).To(gomega.Equal("labeldrop"))
+	g.Expect(decoded.MetricRelabelConfigs[0].Regex).To(gomega.Equal("exception_type"))
+	g.Expect(decoded.MetricRelabelConfigs[1].SourceLabels).To(gomega.ConsistOf(monitoringv1.LabelName("__name__")))
+	g.Expect(decoded.RelabelConfigs).To(gomega.HaveLen(1))
+	g.Expect(decoded.RelabelConfigs[0].TargetLabel).To(gomega.Equal("node"))
+	g.Expect(decoded.RelabelConfigs[0].Replacement).To(gomega.HaveValue(gomega.Equal("$1")))
+}
@@ -30,10 +30,11 @@ func TestMlflowToHelmValues_PodAnnotations(t *testing.T) {
 	renderer := &HelmRenderer{}
 
 	tests := []struct {
-		name            string
-		podAnnotations  map[string]string
-		wantExists      bool
-		wantAnnotations map[string]string
+		name               string
+		podAnnotations     map[string]string
+		referencedDataHash string
+		wantExists         bool
+		wantAnnotations    map[string]string
 	}{
 		{
 			name:       "no annotations - key should not exist",
@@ -59,6 +60,16 @@ func TestMlflowToHelmValues_PodAnnotations(t *testing.T) {
 				"sidecar.istio.io/inject": "false",
 			},
 		},
+		{
+			name:               "referenced data hash is added next to user annotations",
+			podAnnotations:     map[string]string{"prometheus.io/scrape": "true"},
+			referencedDataHash: "0123abcd",
+			wantExists:         true,
+			wantAnnotations: map[string]string{
+				"prometheus.io/scrape":       "true",
+				referencedDataHashAnnotation: "0123abcd",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -73,7 +84,8 @@ func TestMlflowToHelmValues_PodAnnotations(t *testing.T) {
 				},
 			}
 
-			values, err := renderer.mlflowToHelmValues(mlflow, "test-namespace", RenderOptions{}, nil)
+			opts := RenderOptions{ReferencedDataHash: tt.referencedDataHash}
+			values, err := renderer.mlflowToHelmValues(mlflow, "test-namespace", opts, nil)
 			g.Expect(err).NotTo(gomega.HaveOccurred())
 
 			podAnnotations, exists := values["podAnnotations"]
@@ -172,6 +184,22 @@ func TestMlflowToHelmValues_PodLabels(t *testing.T) {
 				"cost-center": "ai-ops",
 			},
 		},
+		{
+			name: "reserved keys are dropped",
+			podLabels: map[string]string{
+				"app":                        "other",
+				"app.kubernetes.io/instance": "other",
+				"component":                  "other",
+				"team":                       "ml-platform",
+			},
+			wantExists: true,
+			wantLabels: map[string]string{"team": "ml-platform"},
+		},
+		{
+			name:       "only reserved keys - key should not exist",
+			podLabels:  map[string]string{"component": "other"},
+			wantExists: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -223,3 +251,33 @@ func TestMlflowToHelmValues_PodLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderChart_PodLabels(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			PodLabels: map[string]string{
+				"cost-center": "ai-ops",
+				"app":         "hijacked",
+				"component":   "hijacked",
+			},
+			PodAnnotations: map[string]string{"sidecar.istio.io/inject": "true"},
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Template.Labels).To(gomega.Equal(map[string]string{
+		"app":                        "mlflow",
+		"app.kubernetes.io/instance": "mlflow",
+		"component":                  "mlflow",
+		"cost-center":                "ai-ops",
+	}))
+	g.Expect(deployment.Spec.Selector.MatchLabels).To(gomega.Equal(map[string]string{"app": "mlflow"}))
+	g.Expect(deployment.Spec.Template.Annotations).To(gomega.HaveKeyWithValue("sidecar.istio.io/inject", "true"))
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestMlflowToHelmValues_OpenShiftRoute(t *testing.T) {
+	tests := []struct {
+		name      string
+		openShift *mlflowv1.OpenShiftSpec
+		tls       *mlflowv1.TLSSpec
+		opts      RenderOptions
+		want      map[string]interface{}
+	}{
+		{
+			name: "disabled by default",
+			opts: RenderOptions{RouteAvailable: true},
+			want: map[string]interface{}{"enabled": false, "tlsTermination": "reencrypt"},
+		},
+		{
+			name:      "enabled route defaults to reencrypt",
+			openShift: &mlflowv1.OpenShiftSpec{Route: &mlflowv1.RouteSpec{Enabled: ptr(true)}},
+			opts:      RenderOptions{RouteAvailable: true},
+			want:      map[string]interface{}{"enabled": true, "tlsTermination": "reencrypt"},
+		},
+		{
+			name: "host and passthrough termination are passed through",
+			openShift: &mlflowv1.OpenShiftSpec{Route: &mlflowv1.RouteSpec{
+				Enabled:        ptr(true),
+				Host:           ptr("mlflow.apps.example.com"),
+				TLSTermination: ptr("passthrough"),
+			}},
+			opts: RenderOptions{RouteAvailable: true},
+			want: map[string]interface{}{
+				"enabled":        true,
+				"host":           "mlflow.apps.example.com",
+				"tlsTermination": "passthrough",
+			},
+		},
+		{
+			name:      "cert-manager certificates default to passthrough",
+			openShift: &mlflowv1.OpenShiftSpec{Route: &mlflowv1.RouteSpec{Enabled: ptr(true)}},
+			tls: &mlflowv1.TLSSpec{CertManager: &mlflowv1.CertManagerConfig{
+				Enabled:   ptr(true),
+				IssuerRef: &mlflowv1.CertManagerIssuerReference{Name: "ca-issuer"},
+			}},
+			opts: RenderOptions{RouteAvailable: true},
+			want: map[string]interface{}{"enabled": true, "tlsTermination": "passthrough"},
+		},
+		{
+			name:      "ignored without the Route API",
+			openShift: &mlflowv1.OpenShiftSpec{Route: &mlflowv1.RouteSpec{Enabled: ptr(true)}},
+			want:      map[string]interface{}{"enabled": false, "tlsTermination": "reencrypt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			values, err := (&HelmRenderer{}).mlflowToHelmValues(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					OpenShift:       tt.openShift,
+					TLS:             tt.tls,
+				},
+			}, "test-ns", tt.opts, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			openShift, ok := values["openShift"].(map[string]interface{})
+			g.Expect(ok).To(gomega.BeTrue(), "openShift values should be a map")
+			g.Expect(openShift["route"]).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestRenderChart_OpenShiftRoute(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+		},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{RouteAvailable: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "Route", "mlflow-team-a")).To(gomega.BeNil())
+
+	mlflow.Spec.OpenShift = &mlflowv1.OpenShiftSpec{Route: &mlflowv1.RouteSpec{
+		Enabled: ptr(true),
+		Host:    ptr("mlflow.apps.example.com"),
+	}}
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{RouteAvailable: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	obj := findObject(objs, "Route", "mlflow-team-a")
+	g.Expect(obj).NotTo(gomega.BeNil())
+
+	var route routev1.Route
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &route)).To(gomega.Succeed())
+	g.Expect(route.Namespace).To(gomega.Equal("test-ns"))
+	g.Expect(route.Spec.Host).To(gomega.Equal("mlflow.apps.example.com"))
+	g.Expect(route.Spec.To.Kind).To(gomega.Equal("Service"))
+	g.Expect(route.Spec.To.Name).To(gomega.Equal("mlflow-team-a"))
+	g.Expect(route.Spec.Port).NotTo(gomega.BeNil())
+	g.Expect(route.Spec.Port.TargetPort.StrVal).To(gomega.Equal("https"))
+	g.Expect(route.Spec.TLS).NotTo(gomega.BeNil())
+	g.Expect(route.Spec.TLS.Termination).To(gomega.Equal(routev1.TLSTerminationReencrypt))
+	g.Expect(route.Spec.TLS.InsecureEdgeTerminationPolicy).To(gomega.Equal(routev1.InsecureEdgeTerminationPolicyRedirect))
+}
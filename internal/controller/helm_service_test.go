@@ -0,0 +1,168 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func TestServiceValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		service *mlflowv1.ServiceSpec
+		want    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "ClusterIP by default",
+			want: map[string]interface{}{"type": "ClusterIP", "port": 8443, "portName": "https", "headless": false},
+		},
+		{
+			name:    "NodePort with an explicit port",
+			service: &mlflowv1.ServiceSpec{Type: ptr(corev1.ServiceTypeNodePort), NodePort: ptr(int32(30443))},
+			want:    map[string]interface{}{"type": "NodePort", "port": 8443, "portName": "https", "headless": false, "nodePort": int32(30443)},
+		},
+		{
+			name:    "LoadBalancer with a requested IP",
+			service: &mlflowv1.ServiceSpec{Type: ptr(corev1.ServiceTypeLoadBalancer), LoadBalancerIP: ptr("192.0.2.10")},
+			want:    map[string]interface{}{"type": "LoadBalancer", "port": 8443, "portName": "https", "headless": false, "loadBalancerIP": "192.0.2.10"},
+		},
+		{
+			name:    "nodePort requires type NodePort",
+			service: &mlflowv1.ServiceSpec{Type: ptr(corev1.ServiceTypeLoadBalancer), NodePort: ptr(int32(30443))},
+			wantErr: "spec.service.nodePort requires spec.service.type NodePort, got LoadBalancer",
+		},
+		{
+			name:    "loadBalancerIP requires type LoadBalancer",
+			service: &mlflowv1.ServiceSpec{LoadBalancerIP: ptr("192.0.2.10")},
+			wantErr: "spec.service.loadBalancerIP requires spec.service.type LoadBalancer, got ClusterIP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := serviceValues(tt.service)
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestRenderChartServiceType(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	render := func(t *testing.T, service *mlflowv1.ServiceSpec) []*unstructured.Unstructured {
+		t.Helper()
+		objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			Spec: mlflowv1.MLflowSpec{
+				BackendStoreURI: ptr(testBackendStoreURI),
+				Service:         service,
+			},
+		}, "test-ns", RenderOptions{}, nil)
+		if err != nil {
+			t.Fatalf("RenderChart() error = %v", err)
+		}
+		return objs
+	}
+	mainService := func(t *testing.T, objs []*unstructured.Unstructured) corev1.Service {
+		t.Helper()
+		obj := findObject(objs, "Service", "mlflow")
+		if obj == nil {
+			t.Fatalf("Service mlflow not rendered")
+		}
+		var service corev1.Service
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &service); err != nil {
+			t.Fatalf("failed to convert Service: %v", err)
+		}
+		return service
+	}
+	// ingressFrom returns the sources allowed by the MLflow NetworkPolicy's
+	// single ingress rule; none means any source.
+	ingressFrom := func(t *testing.T, objs []*unstructured.Unstructured) []interface{} {
+		t.Helper()
+		np := findObject(objs, "NetworkPolicy", "mlflow")
+		if np == nil {
+			t.Fatalf("NetworkPolicy mlflow not rendered")
+		}
+		rules, _, _ := unstructured.NestedSlice(np.Object, "spec", "ingress")
+		if len(rules) != 1 {
+			t.Fatalf("ingress rules = %v, want one", rules)
+		}
+		from, _, _ := unstructured.NestedSlice(rules[0].(map[string]interface{}), "from")
+		return from
+	}
+
+	t.Run("ClusterIP by default", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		objs := render(t, nil)
+		service := mainService(t, objs)
+		g.Expect(service.Spec.Type).To(gomega.Equal(corev1.ServiceTypeClusterIP))
+		g.Expect(service.Spec.Ports).To(gomega.HaveLen(1))
+		g.Expect(service.Spec.Ports[0].NodePort).To(gomega.BeZero())
+		g.Expect(service.Spec.LoadBalancerIP).To(gomega.BeEmpty())
+		g.Expect(ingressFrom(t, objs)).NotTo(gomega.BeEmpty())
+	})
+
+	t.Run("NodePort with an explicit port", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		objs := render(t, &mlflowv1.ServiceSpec{Type: ptr(corev1.ServiceTypeNodePort), NodePort: ptr(int32(30443)), Headless: ptr(true)})
+		service := mainService(t, objs)
+		g.Expect(service.Spec.Type).To(gomega.Equal(corev1.ServiceTypeNodePort))
+		g.Expect(service.Spec.Ports).To(gomega.HaveLen(1))
+		g.Expect(service.Spec.Ports[0].NodePort).To(gomega.Equal(int32(30443)))
+		g.Expect(ingressFrom(t, objs)).To(gomega.BeEmpty())
+
+		// The headless Service keeps clusterIP None and gets no node port.
+		headless := findObject(objs, "Service", "mlflow-headless")
+		g.Expect(headless).NotTo(gomega.BeNil())
+		g.Expect(headless.Object["spec"]).NotTo(gomega.HaveKey("type"))
+		ports, _, _ := unstructured.NestedSlice(headless.Object, "spec", "ports")
+		g.Expect(ports).To(gomega.HaveLen(1))
+		g.Expect(ports[0]).NotTo(gomega.HaveKey("nodePort"))
+	})
+
+	t.Run("NodePort without a port leaves allocation to Kubernetes", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		service := mainService(t, render(t, &mlflowv1.ServiceSpec{Type: ptr(corev1.ServiceTypeNodePort)}))
+		g.Expect(service.Spec.Type).To(gomega.Equal(corev1.ServiceTypeNodePort))
+		g.Expect(service.Spec.Ports[0].NodePort).To(gomega.BeZero())
+	})
+
+	t.Run("LoadBalancer", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		objs := render(t, &mlflowv1.ServiceSpec{Type: ptr(corev1.ServiceTypeLoadBalancer), LoadBalancerIP: ptr("192.0.2.10")})
+		service := mainService(t, objs)
+		g.Expect(service.Spec.Type).To(gomega.Equal(corev1.ServiceTypeLoadBalancer))
+		g.Expect(service.Spec.LoadBalancerIP).To(gomega.Equal("192.0.2.10"))
+		g.Expect(service.Spec.Ports[0].NodePort).To(gomega.BeZero())
+		g.Expect(ingressFrom(t, objs)).To(gomega.BeEmpty())
+	})
+}
@@ -17,12 +17,16 @@ limitations under the License.
 package controller
 
 import (
+	"strings"
 	"testing"
 
 	gomega "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 )
@@ -119,3 +123,462 @@ func TestMlflowToHelmValues_Storage(t *testing.T) {
 		})
 	}
 }
+
+func TestMlflowToHelmValues_ArtifactsSubPath(t *testing.T) {
+	renderer := &HelmRenderer{}
+
+	tests := []struct {
+		name        string
+		spec        mlflowv1.MLflowSpec
+		wantErr     string
+		wantSubPath string
+		wantDest    string
+	}{
+		{
+			name: "subPath derives the artifacts destination",
+			spec: mlflowv1.MLflowSpec{
+				BackendStoreURI:  ptr("sqlite:////mlflow/mlflow.db"),
+				Storage:          &corev1.PersistentVolumeClaimSpec{},
+				ServeArtifacts:   ptr(true),
+				ArtifactsSubPath: ptr("artifacts"),
+			},
+			wantSubPath: "artifacts",
+			wantDest:    "file:///mlflow-artifacts",
+		},
+		{
+			name: "subPath keeps an explicit file destination",
+			spec: mlflowv1.MLflowSpec{
+				BackendStoreURI:      ptr("sqlite:////mlflow/mlflow.db"),
+				Storage:              &corev1.PersistentVolumeClaimSpec{},
+				ServeArtifacts:       ptr(true),
+				ArtifactsDestination: ptr("file:///mlflow-artifacts/experiments"),
+				ArtifactsSubPath:     ptr("team-a/artifacts"),
+			},
+			wantSubPath: "team-a/artifacts",
+			wantDest:    "file:///mlflow-artifacts/experiments",
+		},
+		{
+			name: "subPath without storage is rejected",
+			spec: mlflowv1.MLflowSpec{
+				BackendStoreURI:  ptr(testBackendStoreURI),
+				ServeArtifacts:   ptr(true),
+				ArtifactsSubPath: ptr("artifacts"),
+			},
+			wantErr: "artifactsSubPath requires storage",
+		},
+		{
+			name: "subPath with remote artifacts is rejected",
+			spec: mlflowv1.MLflowSpec{
+				BackendStoreURI:      ptr(testBackendStoreURI),
+				Storage:              &corev1.PersistentVolumeClaimSpec{},
+				ServeArtifacts:       ptr(true),
+				ArtifactsDestination: ptr("s3://bucket/artifacts"),
+				ArtifactsSubPath:     ptr("artifacts"),
+			},
+			wantErr: "artifactsSubPath can only be used with file-based artifactsDestination",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       tt.spec,
+			}
+			values, err := renderer.mlflowToHelmValues(mlflow, "test-namespace", RenderOptions{}, nil)
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			storage := values["storage"].(map[string]interface{})
+			g.Expect(storage["artifactsSubPath"]).To(gomega.Equal(tt.wantSubPath))
+
+			mlflowValues := values["mlflow"].(map[string]interface{})
+			g.Expect(mlflowValues["artifactsDestination"]).To(gomega.Equal(tt.wantDest))
+		})
+	}
+}
+
+func TestRenderChart_ArtifactsSubPath(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:  ptr("sqlite:////mlflow/mlflow.db"),
+			Storage:          &corev1.PersistentVolumeClaimSpec{},
+			ServeArtifacts:   ptr(true),
+			ArtifactsSubPath: ptr("artifacts"),
+		},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment := findObject(objs, deploymentKind, "mlflow")
+	g.Expect(deployment).NotTo(gomega.BeNil())
+
+	containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	container := containers[0].(map[string]interface{})
+
+	var storageMounts []map[string]interface{}
+	for _, m := range container["volumeMounts"].([]interface{}) {
+		mount := m.(map[string]interface{})
+		if mount["name"] == "mlflow-storage" {
+			storageMounts = append(storageMounts, mount)
+		}
+	}
+	g.Expect(storageMounts).To(gomega.HaveLen(2))
+	g.Expect(storageMounts[0]["mountPath"]).To(gomega.Equal("/mlflow"))
+	g.Expect(storageMounts[0]).NotTo(gomega.HaveKey("subPath"))
+	g.Expect(storageMounts[1]["mountPath"]).To(gomega.Equal(artifactsSubPathMount))
+	g.Expect(storageMounts[1]["subPath"]).To(gomega.Equal("artifacts"))
+
+	args := container["args"].([]interface{})
+	g.Expect(args).To(gomega.ContainElement("--artifacts-destination=file:///mlflow-artifacts"))
+}
+
+func TestRenderChart_ExistingStorageClaim(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	tests := []struct {
+		name           string
+		storage        *corev1.PersistentVolumeClaimSpec
+		wantAccessMode string
+	}{
+		{
+			name:           "existing claim alone",
+			wantAccessMode: "ReadWriteOnce",
+		},
+		{
+			name: "storage declares the claim's access mode",
+			storage: &corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+				},
+			},
+			wantAccessMode: "ReadWriteMany",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "team"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:      ptr("sqlite:////mlflow/mlflow.db"),
+					Storage:              tt.storage,
+					ExistingStorageClaim: ptr("shared-mlflow-data"),
+				},
+			}
+
+			values, err := renderer.mlflowToHelmValues(mlflow, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			storage := values["storage"].(map[string]interface{})
+			g.Expect(storage["enabled"]).To(gomega.BeTrue())
+			g.Expect(storage["existingClaim"]).To(gomega.Equal("shared-mlflow-data"))
+			g.Expect(storage["accessMode"]).To(gomega.Equal(tt.wantAccessMode))
+
+			objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			for _, obj := range objs {
+				g.Expect(obj.GetKind()).NotTo(gomega.Equal("PersistentVolumeClaim"))
+			}
+
+			deployment, err := renderedDeployment(objs, "mlflow-team", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			var claimName string
+			for _, volume := range deployment.Spec.Template.Spec.Volumes {
+				if volume.Name == "mlflow-storage" && volume.PersistentVolumeClaim != nil {
+					claimName = volume.PersistentVolumeClaim.ClaimName
+				}
+			}
+			g.Expect(claimName).To(gomega.Equal("shared-mlflow-data"))
+			container := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow")
+			g.Expect(container).NotTo(gomega.BeNil())
+			g.Expect(container.VolumeMounts).To(gomega.ContainElement(gomega.And(
+				gomega.HaveField("Name", "mlflow-storage"),
+				gomega.HaveField("MountPath", "/mlflow"),
+			)))
+		})
+	}
+}
+
+func TestRenderChart_StorageClaimName(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	tests := []struct {
+		name      string
+		claimName *string
+		want      string
+	}{
+		{
+			name: "defaults to mlflow-pvc with the resource suffix",
+			want: "mlflow-pvc-team",
+		},
+		{
+			name:      "custom name",
+			claimName: ptr("mlflow-data"),
+			want:      "mlflow-data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "team"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr("sqlite:////mlflow/mlflow.db"),
+					Storage: &corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					},
+					StorageClaimName:  tt.claimName,
+					GarbageCollection: &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"},
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			var pvcNames []string
+			for _, obj := range objs {
+				if obj.GetKind() == "PersistentVolumeClaim" {
+					pvcNames = append(pvcNames, obj.GetName())
+				}
+			}
+			g.Expect(pvcNames).To(gomega.Equal([]string{tt.want}))
+
+			// Every workload that mounts the storage refers to the rendered PVC.
+			deployment, err := renderedDeployment(objs, "mlflow-team", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			podSpecs := map[string]corev1.PodSpec{"Deployment": deployment.Spec.Template.Spec}
+			cronJob := findObject(objs, "CronJob", "mlflow-gc-team")
+			g.Expect(cronJob).NotTo(gomega.BeNil())
+			var gc batchv1.CronJob
+			g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(cronJob.Object, &gc)).To(gomega.Succeed())
+			podSpecs["CronJob"] = gc.Spec.JobTemplate.Spec.Template.Spec
+			for workload, podSpec := range podSpecs {
+				var claimName string
+				for _, volume := range podSpec.Volumes {
+					if volume.Name == "mlflow-storage" && volume.PersistentVolumeClaim != nil {
+						claimName = volume.PersistentVolumeClaim.ClaimName
+					}
+				}
+				g.Expect(claimName).To(gomega.Equal(tt.want), workload)
+			}
+		})
+	}
+}
+
+func TestRenderChart_TmpVolumeSizeLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		sizeLimit *resource.Quantity
+		want      string
+	}{
+		{
+			name: "defaults to 128Mi",
+			want: defaultTmpVolumeSizeLimit,
+		},
+		{
+			name:      "uses configured size limit",
+			sizeLimit: ptr(resource.MustParse("1Gi")),
+			want:      "1Gi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			renderer := NewHelmRenderer("../../charts/mlflow")
+
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:    ptr(testBackendStoreURI),
+					TmpVolumeSizeLimit: tt.sizeLimit,
+				},
+			}
+
+			objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment := findObject(objs, deploymentKind, "mlflow")
+			g.Expect(deployment).NotTo(gomega.BeNil())
+
+			volumes, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "volumes")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			var tmpVolume map[string]interface{}
+			for _, v := range volumes {
+				volume := v.(map[string]interface{})
+				if volume["name"] == "tmp" {
+					tmpVolume = volume
+				}
+			}
+			g.Expect(tmpVolume).NotTo(gomega.BeNil())
+			sizeLimit, _, err := unstructured.NestedString(tmpVolume, "emptyDir", "sizeLimit")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(sizeLimit).To(gomega.Equal(tt.want))
+
+			containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			container := containers[0].(map[string]interface{})
+			g.Expect(container["volumeMounts"]).To(gomega.ContainElement(gomega.And(
+				gomega.HaveKeyWithValue("name", "tmp"),
+				gomega.HaveKeyWithValue("mountPath", "/tmp"),
+			)))
+		})
+	}
+}
+
+func TestRenderChart_ArtifactProxySharesStaticPrefix(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	objs, err := renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:      ptr(testBackendStoreURI),
+			ServeArtifacts:       ptr(true),
+			ArtifactsDestination: ptr("s3://bucket/artifacts"),
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment := findObject(objs, deploymentKind, "mlflow")
+	g.Expect(deployment).NotTo(gomega.BeNil())
+	containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	args := containers[0].(map[string]interface{})["args"].([]interface{})
+
+	// MLflow mounts the artifact proxy routes under --static-prefix; there is
+	// no separate proxy prefix, so exactly one prefix flag must be rendered.
+	var prefixArgs []string
+	for _, arg := range args {
+		if s := arg.(string); strings.Contains(s, "prefix") {
+			prefixArgs = append(prefixArgs, s)
+		}
+	}
+	g.Expect(args).To(gomega.ContainElement("--serve-artifacts"))
+	g.Expect(prefixArgs).To(gomega.Equal([]string{"--static-prefix=" + StaticPrefix}))
+}
+
+func TestMlflowToHelmValues_ArtifactsReadOnly(t *testing.T) {
+	renderer := &HelmRenderer{}
+	spec := func(accessMode corev1.PersistentVolumeAccessMode, subPath *string) mlflowv1.MLflowSpec {
+		return mlflowv1.MLflowSpec{
+			BackendStoreURI:   ptr(testBackendStoreURI),
+			Storage:           &corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{accessMode}},
+			ServeArtifacts:    ptr(true),
+			ArtifactsSubPath:  subPath,
+			ArtifactsReadOnly: ptr(true),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		spec         mlflowv1.MLflowSpec
+		wantErr      string
+		wantReadOnly bool
+	}{
+		{
+			name: "ReadWriteMany keeps the PVC writable",
+			spec: spec(corev1.ReadWriteMany, ptr("artifacts")),
+		},
+		{
+			name:         "ReadOnlyMany mounts the whole PVC read-only",
+			spec:         spec(corev1.ReadOnlyMany, ptr("artifacts")),
+			wantReadOnly: true,
+		},
+		{
+			name:    "ReadWriteOnce is rejected",
+			spec:    spec(corev1.ReadWriteOnce, ptr("artifacts")),
+			wantErr: "artifactsReadOnly requires a ReadWriteMany or ReadOnlyMany storage access mode",
+		},
+		{
+			name:    "artifactsSubPath is required",
+			spec:    spec(corev1.ReadWriteMany, nil),
+			wantErr: "artifactsReadOnly requires artifactsSubPath",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			values, err := renderer.mlflowToHelmValues(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       tt.spec,
+			}, "test-namespace", RenderOptions{}, nil)
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			storage := values["storage"].(map[string]interface{})
+			g.Expect(storage["artifactsReadOnly"]).To(gomega.BeTrue())
+			g.Expect(storage["readOnly"]).To(gomega.Equal(tt.wantReadOnly))
+		})
+	}
+}
+
+func TestRenderChart_ArtifactsReadOnly(t *testing.T) {
+	tests := []struct {
+		name         string
+		accessMode   corev1.PersistentVolumeAccessMode
+		wantReadOnly bool
+	}{
+		{name: "ReadWriteMany", accessMode: corev1.ReadWriteMany},
+		{name: "ReadOnlyMany", accessMode: corev1.ReadOnlyMany, wantReadOnly: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:   ptr(testBackendStoreURI),
+					Storage:           &corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{tt.accessMode}},
+					ServeArtifacts:    ptr(true),
+					ArtifactsSubPath:  ptr("artifacts"),
+					ArtifactsReadOnly: ptr(true),
+					Replicas:          ptr(int32(3)),
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			// The shared volume can be attached to old and new pods at once.
+			g.Expect(string(deployment.Spec.Strategy.Type)).To(gomega.Equal("RollingUpdate"))
+
+			mounts := map[string]corev1.VolumeMount{}
+			for _, mount := range findContainer(deployment.Spec.Template.Spec.Containers, "mlflow").VolumeMounts {
+				if mount.Name == "mlflow-storage" {
+					mounts[mount.MountPath] = mount
+				}
+			}
+			g.Expect(mounts).To(gomega.HaveLen(2))
+			g.Expect(mounts[artifactsSubPathMount].ReadOnly).To(gomega.BeTrue())
+			g.Expect(mounts[artifactsSubPathMount].SubPath).To(gomega.Equal("artifacts"))
+			g.Expect(mounts["/mlflow"].ReadOnly).To(gomega.Equal(tt.wantReadOnly))
+
+			var claim *corev1.PersistentVolumeClaimVolumeSource
+			for _, volume := range deployment.Spec.Template.Spec.Volumes {
+				if volume.Name == "mlflow-storage" {
+					claim = volume.PersistentVolumeClaim
+				}
+			}
+			g.Expect(claim).NotTo(gomega.BeNil())
+			g.Expect(claim.ReadOnly).To(gomega.Equal(tt.wantReadOnly))
+		})
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// extraVolumesMLflow returns an MLflow with a projected ConfigMap volume
+// mounted through spec.extraVolumes and spec.extraVolumeMounts.
+func extraVolumesMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			ExtraVolumes: []corev1.Volume{{
+				Name: "plugin-config",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{{
+							ConfigMap: &corev1.ConfigMapProjection{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "mlflow-plugins"},
+								Items:                []corev1.KeyToPath{{Key: "plugins.yaml", Path: "plugins.yaml"}},
+							},
+						}},
+					},
+				},
+			}},
+			ExtraVolumeMounts: []corev1.VolumeMount{{
+				Name:      "plugin-config",
+				MountPath: "/etc/mlflow/plugins",
+				ReadOnly:  true,
+			}},
+		},
+	}
+}
+
+func TestRenderChart_ExtraVolumes(t *testing.T) {
+	g := gomega.NewWithT(t)
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(extraVolumesMLflow(), "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	var volume *corev1.Volume
+	for i := range deployment.Spec.Template.Spec.Volumes {
+		if deployment.Spec.Template.Spec.Volumes[i].Name == "plugin-config" {
+			volume = &deployment.Spec.Template.Spec.Volumes[i]
+		}
+	}
+	g.Expect(volume).NotTo(gomega.BeNil(), "plugin-config volume should be rendered")
+	g.Expect(volume.Projected).NotTo(gomega.BeNil())
+	g.Expect(volume.Projected.Sources).To(gomega.HaveLen(1))
+	g.Expect(volume.Projected.Sources[0].ConfigMap).NotTo(gomega.BeNil())
+	g.Expect(volume.Projected.Sources[0].ConfigMap.Name).To(gomega.Equal("mlflow-plugins"))
+	g.Expect(volume.Projected.Sources[0].ConfigMap.Items).To(gomega.Equal([]corev1.KeyToPath{{Key: "plugins.yaml", Path: "plugins.yaml"}}))
+
+	// The operator-managed volumes are still rendered alongside.
+	g.Expect(deployment.Spec.Template.Spec.Volumes).To(gomega.ContainElement(
+		gomega.HaveField("Name", "tmp"),
+	))
+
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(gomega.ContainElement(corev1.VolumeMount{
+		Name:      "plugin-config",
+		MountPath: "/etc/mlflow/plugins",
+		ReadOnly:  true,
+	}))
+}
+
+func TestRenderChart_ExtraVolumesKeepQuantities(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := extraVolumesMLflow()
+	mlflow.Spec.ExtraVolumes = append(mlflow.Spec.ExtraVolumes, corev1.Volume{
+		Name: "scratch",
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: ptr(resource.MustParse("1Gi"))},
+		},
+	})
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	var sizeLimit string
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name == "scratch" && volume.EmptyDir != nil && volume.EmptyDir.SizeLimit != nil {
+			sizeLimit = volume.EmptyDir.SizeLimit.String()
+		}
+	}
+	g.Expect(sizeLimit).To(gomega.Equal("1Gi"))
+}
+
+func TestBuildMigrationJobFromDeployment_ExtraVolumeMounts(t *testing.T) {
+	tests := []struct {
+		name       string
+		migration  *mlflowv1.MLflowMigrationConfig
+		wantMounts bool
+	}{
+		{
+			name: "extra mounts are dropped by default",
+		},
+		{
+			name:       "allowlisted mounts are kept",
+			migration:  &mlflowv1.MLflowMigrationConfig{ExtraVolumeMounts: []string{"plugin-config"}},
+			wantMounts: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := extraVolumesMLflow()
+			mlflow.Spec.Migration = tt.migration
+
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			job, err := buildMigrationJobFromDeployment(mlflow, deployment, "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			podSpec := job.Spec.Template.Spec
+			mountMatcher := gomega.ContainElement(gomega.HaveField("Name", "plugin-config"))
+			volumeMatcher := gomega.ContainElement(gomega.HaveField("Name", "plugin-config"))
+			if tt.wantMounts {
+				g.Expect(podSpec.Containers[0].VolumeMounts).To(mountMatcher)
+				g.Expect(podSpec.Volumes).To(volumeMatcher)
+			} else {
+				g.Expect(podSpec.Containers[0].VolumeMounts).NotTo(mountMatcher)
+				g.Expect(podSpec.Volumes).NotTo(volumeMatcher)
+			}
+			// Operator-managed mounts are unaffected by the allowlist.
+			g.Expect(podSpec.Containers[0].VolumeMounts).To(gomega.ContainElement(gomega.HaveField("Name", "tmp")))
+		})
+	}
+}
+
+// TestRenderChart_CredentialMountsReadOnly audits every rendered workload and
+// the migration Job: Secret, ConfigMap, projected and downwardAPI volumes must
+// be mounted read-only.
+func TestRenderChart_CredentialMountsReadOnly(t *testing.T) {
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:      ptr(testBackendStoreURI),
+			ServeArtifacts:       ptr(true),
+			ArtifactsDestination: ptr("s3://bucket/artifacts"),
+			ArtifactStore: &mlflowv1.ArtifactStoreSpec{
+				S3: &mlflowv1.S3ArtifactStoreSpec{AssumeRoleARN: ptr("arn:aws:iam::123456789012:role/mlflow")},
+			},
+			CABundleConfigMap: &mlflowv1.CABundleConfigMapSpec{Name: "my-ca"},
+			GarbageCollection: &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"},
+			TraceArchival: &mlflowv1.TraceArchivalSpec{
+				Enabled:  true,
+				Schedule: ptr("*/5 * * * *"),
+			},
+			TLS: &mlflowv1.TLSSpec{
+				CertManager: &mlflowv1.CertManagerConfig{
+					Enabled:   ptr(true),
+					IssuerRef: &mlflowv1.CertManagerIssuerReference{Name: "mlflow-ca"},
+				},
+			},
+			ExtraVolumes: []corev1.Volume{{
+				Name: "db-credentials",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: "mlflow-db"},
+				},
+			}},
+			// readOnly is deliberately left unset.
+			ExtraVolumeMounts: []corev1.VolumeMount{{
+				Name:      "db-credentials",
+				MountPath: "/etc/mlflow/db",
+			}},
+			Migration: &mlflowv1.MLflowMigrationConfig{ExtraVolumeMounts: []string{"db-credentials"}},
+		},
+	}
+
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{PlatformTrustedCABundleExists: true}, nil)
+	if err != nil {
+		t.Fatalf("RenderChart() error = %v", err)
+	}
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	if err != nil {
+		t.Fatalf("renderedDeployment() error = %v", err)
+	}
+	job, err := buildMigrationJobFromDeployment(mlflow, deployment, "test-ns")
+	if err != nil {
+		t.Fatalf("buildMigrationJobFromDeployment() error = %v", err)
+	}
+
+	podSpecs := map[string]corev1.PodSpec{
+		"Deployment":    deployment.Spec.Template.Spec,
+		"migration Job": job.Spec.Template.Spec,
+	}
+	for _, name := range []string{"mlflow-gc", "mlflow-trace-archival"} {
+		obj := findObject(objs, "CronJob", name)
+		if obj == nil {
+			t.Fatalf("CronJob %s not found", name)
+		}
+		var cronJob batchv1.CronJob
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &cronJob); err != nil {
+			t.Fatalf("failed to convert CronJob %s: %v", name, err)
+		}
+		podSpecs["CronJob "+name] = cronJob.Spec.JobTemplate.Spec.Template.Spec
+	}
+
+	for workload, podSpec := range podSpecs {
+		credentialVolumes := map[string]bool{}
+		for _, volume := range podSpec.Volumes {
+			credentialVolumes[volume.Name] = volume.Secret != nil || volume.ConfigMap != nil ||
+				volume.Projected != nil || volume.DownwardAPI != nil
+		}
+		checked := 0
+		for _, container := range append(podSpec.InitContainers, podSpec.Containers...) {
+			for _, mount := range container.VolumeMounts {
+				if !credentialVolumes[mount.Name] {
+					continue
+				}
+				checked++
+				if !mount.ReadOnly {
+					t.Errorf("%s: container %s mounts %s at %s without readOnly", workload, container.Name, mount.Name, mount.MountPath)
+				}
+			}
+		}
+		if checked == 0 {
+			t.Errorf("%s: no credential mounts found", workload)
+		}
+	}
+}
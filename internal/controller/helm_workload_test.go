@@ -20,9 +20,14 @@ import (
 	"testing"
 
 	gomega "github.com/onsi/gomega"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 )
@@ -114,6 +119,42 @@ func TestMlflowToHelmValues_Resources(t *testing.T) {
 	}
 }
 
+func TestRenderChart_ExtendedResources(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse("500m"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+					"nvidia.com/gpu":                resource.MustParse("1"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+					"nvidia.com/gpu":                resource.MustParse("1"),
+					"hugepages-2Mi":                 resource.MustParse("64Mi"),
+				},
+			},
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	resources := deployment.Spec.Template.Spec.Containers[0].Resources
+
+	// Every resource name reaches the container, not just cpu and memory.
+	g.Expect(resources.Requests).To(gomega.HaveKeyWithValue(corev1.ResourceName("nvidia.com/gpu"), resource.MustParse("1")))
+	g.Expect(resources.Requests).To(gomega.HaveKeyWithValue(corev1.ResourceEphemeralStorage, resource.MustParse("1Gi")))
+	g.Expect(resources.Limits).To(gomega.HaveKeyWithValue(corev1.ResourceName("nvidia.com/gpu"), resource.MustParse("1")))
+	g.Expect(resources.Limits).To(gomega.HaveKeyWithValue(corev1.ResourceEphemeralStorage, resource.MustParse("2Gi")))
+	g.Expect(resources.Limits).To(gomega.HaveKeyWithValue(corev1.ResourceName("hugepages-2Mi"), resource.MustParse("64Mi")))
+	g.Expect(resources.Requests.Cpu().String()).To(gomega.Equal("500m"))
+}
+
 func TestMlflowToHelmValues_Replicas(t *testing.T) {
 	renderer := &HelmRenderer{}
 
@@ -159,6 +200,312 @@ func TestMlflowToHelmValues_Replicas(t *testing.T) {
 	}
 }
 
+func TestMlflowToHelmValues_Autoscaling(t *testing.T) {
+	renderer := &HelmRenderer{}
+
+	tests := []struct {
+		name            string
+		autoscaling     *mlflowv1.AutoscalingConfig
+		wantAutoscaling map[string]interface{}
+		wantReplicas    bool
+	}{
+		{
+			name:            "not configured keeps a fixed replica count",
+			wantAutoscaling: map[string]interface{}{"enabled": false},
+			wantReplicas:    true,
+		},
+		{
+			name:            "disabled keeps a fixed replica count",
+			autoscaling:     &mlflowv1.AutoscalingConfig{Enabled: ptr(false), MaxReplicas: 5},
+			wantAutoscaling: map[string]interface{}{"enabled": false},
+			wantReplicas:    true,
+		},
+		{
+			name:        "enabled applies defaults and drops replicaCount",
+			autoscaling: &mlflowv1.AutoscalingConfig{Enabled: ptr(true), MaxReplicas: 5},
+			wantAutoscaling: map[string]interface{}{
+				"enabled":                        true,
+				"minReplicas":                    int32(1),
+				"maxReplicas":                    int32(5),
+				"targetCPUUtilizationPercentage": int32(80),
+			},
+		},
+		{
+			name: "enabled passes explicit bounds and target",
+			autoscaling: &mlflowv1.AutoscalingConfig{
+				Enabled:                        ptr(true),
+				MinReplicas:                    ptr(int32(2)),
+				MaxReplicas:                    10,
+				TargetCPUUtilizationPercentage: ptr(int32(60)),
+			},
+			wantAutoscaling: map[string]interface{}{
+				"enabled":                        true,
+				"minReplicas":                    int32(2),
+				"maxReplicas":                    int32(10),
+				"targetCPUUtilizationPercentage": int32(60),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			values, err := renderer.mlflowToHelmValues(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					Replicas:        ptr(int32(3)),
+					Autoscaling:     tt.autoscaling,
+				},
+			}, "test-namespace", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			g.Expect(values["autoscaling"]).To(gomega.Equal(tt.wantAutoscaling))
+			if tt.wantReplicas {
+				g.Expect(values).To(gomega.HaveKeyWithValue("replicaCount", int32(3)))
+			} else {
+				g.Expect(values).NotTo(gomega.HaveKey("replicaCount"))
+			}
+		})
+	}
+}
+
+func TestRenderChart_Autoscaling(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			Replicas:        ptr(int32(3)),
+		},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(findObject(objs, "HorizontalPodAutoscaler", "mlflow")).To(gomega.BeNil())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Replicas).To(gomega.Equal(ptr(int32(3))))
+
+	mlflow.Spec.Autoscaling = &mlflowv1.AutoscalingConfig{
+		Enabled:                        ptr(true),
+		MinReplicas:                    ptr(int32(2)),
+		MaxReplicas:                    6,
+		TargetCPUUtilizationPercentage: ptr(int32(70)),
+	}
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err = renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Replicas).To(gomega.BeNil(), "replicas must be left to the HorizontalPodAutoscaler")
+
+	obj := findObject(objs, "HorizontalPodAutoscaler", "mlflow")
+	g.Expect(obj).NotTo(gomega.BeNil())
+	var hpa autoscalingv2.HorizontalPodAutoscaler
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &hpa)).To(gomega.Succeed())
+	g.Expect(hpa.Namespace).To(gomega.Equal("test-ns"))
+	g.Expect(hpa.Spec.ScaleTargetRef).To(gomega.Equal(autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "mlflow",
+	}))
+	g.Expect(hpa.Spec.MinReplicas).To(gomega.Equal(ptr(int32(2))))
+	g.Expect(hpa.Spec.MaxReplicas).To(gomega.Equal(int32(6)))
+	g.Expect(hpa.Spec.Metrics).To(gomega.HaveLen(1))
+	g.Expect(hpa.Spec.Metrics[0].Resource).NotTo(gomega.BeNil())
+	g.Expect(hpa.Spec.Metrics[0].Resource.Name).To(gomega.Equal(corev1.ResourceCPU))
+	g.Expect(hpa.Spec.Metrics[0].Resource.Target.AverageUtilization).To(gomega.Equal(ptr(int32(70))))
+}
+
+func TestRenderChart_PodDisruptionBudget(t *testing.T) {
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	render := func(pdb *mlflowv1.PDBConfig) ([]*unstructured.Unstructured, error) {
+		return renderer.RenderChart(&mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+			Spec: mlflowv1.MLflowSpec{
+				BackendStoreURI:     ptr(testBackendStoreURI),
+				PodDisruptionBudget: pdb,
+			},
+		}, "test-ns", RenderOptions{}, nil)
+	}
+	renderedPDB := func(g *gomega.WithT, objs []*unstructured.Unstructured) *policyv1.PodDisruptionBudget {
+		obj := findObject(objs, "PodDisruptionBudget", "mlflow-team-a")
+		g.Expect(obj).NotTo(gomega.BeNil())
+		pdb := &policyv1.PodDisruptionBudget{}
+		g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pdb)).To(gomega.Succeed())
+		return pdb
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		objs, err := render(nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(findObject(objs, "PodDisruptionBudget", "mlflow-team-a")).To(gomega.BeNil())
+	})
+
+	t.Run("minAvailable 1 selects the MLflow pods", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		minAvailable := intstr.FromInt32(1)
+		objs, err := render(&mlflowv1.PDBConfig{Enabled: ptr(true), MinAvailable: &minAvailable})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		pdb := renderedPDB(g, objs)
+		g.Expect(pdb.Namespace).To(gomega.Equal("test-ns"))
+		g.Expect(pdb.Spec.MinAvailable).To(gomega.Equal(&minAvailable))
+		g.Expect(pdb.Spec.MaxUnavailable).To(gomega.BeNil())
+		g.Expect(pdb.Spec.Selector).NotTo(gomega.BeNil())
+		g.Expect(pdb.Spec.Selector.MatchLabels).To(gomega.Equal(map[string]string{"app": "mlflow-team-a"}))
+
+		deployment, err := renderedDeployment(objs, "mlflow-team-a", "test-ns")
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(deployment.Spec.Template.Labels).To(gomega.HaveKeyWithValue("app", "mlflow-team-a"))
+	})
+
+	t.Run("defaults to minAvailable 1", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		objs, err := render(&mlflowv1.PDBConfig{Enabled: ptr(true)})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(renderedPDB(g, objs).Spec.MinAvailable).To(gomega.Equal(ptr(intstr.FromInt32(1))))
+	})
+
+	t.Run("maxUnavailable keeps a percentage", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		maxUnavailable := intstr.FromString("25%")
+		objs, err := render(&mlflowv1.PDBConfig{Enabled: ptr(true), MaxUnavailable: &maxUnavailable})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		pdb := renderedPDB(g, objs)
+		g.Expect(pdb.Spec.MaxUnavailable).To(gomega.Equal(&maxUnavailable))
+		g.Expect(pdb.Spec.MinAvailable).To(gomega.BeNil())
+	})
+
+	t.Run("minAvailable and maxUnavailable are mutually exclusive", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		minAvailable := intstr.FromInt32(1)
+		maxUnavailable := intstr.FromInt32(1)
+		_, err := render(&mlflowv1.PDBConfig{Enabled: ptr(true), MinAvailable: &minAvailable, MaxUnavailable: &maxUnavailable})
+		g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("mutually exclusive")))
+	})
+
+	renderScaled := func(spec mlflowv1.MLflowSpec) ([]*unstructured.Unstructured, error) {
+		spec.BackendStoreURI = ptr(testBackendStoreURI)
+		return renderer.RenderChart(&mlflowv1.MLflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+			Spec:       spec,
+		}, "test-ns", RenderOptions{}, nil)
+	}
+
+	t.Run("added automatically for multiple replicas", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		objs, err := renderScaled(mlflowv1.MLflowSpec{Replicas: ptr(int32(3))})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		pdb := renderedPDB(g, objs)
+		g.Expect(pdb.Spec.MaxUnavailable).To(gomega.Equal(ptr(intstr.FromInt32(1))))
+		g.Expect(pdb.Spec.MinAvailable).To(gomega.BeNil())
+	})
+
+	t.Run("added automatically for autoscaling with minReplicas above one", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		objs, err := renderScaled(mlflowv1.MLflowSpec{
+			Autoscaling: &mlflowv1.AutoscalingConfig{Enabled: ptr(true), MinReplicas: ptr(int32(2)), MaxReplicas: 5},
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(renderedPDB(g, objs).Spec.MaxUnavailable).To(gomega.Equal(ptr(intstr.FromInt32(1))))
+
+		// spec.replicas is ignored while autoscaling owns the replica count
+		objs, err = renderScaled(mlflowv1.MLflowSpec{
+			Replicas:    ptr(int32(3)),
+			Autoscaling: &mlflowv1.AutoscalingConfig{Enabled: ptr(true), MaxReplicas: 5},
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(findObject(objs, "PodDisruptionBudget", "mlflow-team-a")).To(gomega.BeNil())
+	})
+
+	t.Run("opt out of the automatic budget", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		objs, err := renderScaled(mlflowv1.MLflowSpec{
+			Replicas:            ptr(int32(3)),
+			PodDisruptionBudget: &mlflowv1.PDBConfig{Enabled: ptr(false)},
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(findObject(objs, "PodDisruptionBudget", "mlflow-team-a")).To(gomega.BeNil())
+	})
+
+	t.Run("explicit config wins over the automatic budget", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		minAvailable := intstr.FromString("50%")
+		objs, err := renderScaled(mlflowv1.MLflowSpec{
+			Replicas:            ptr(int32(3)),
+			PodDisruptionBudget: &mlflowv1.PDBConfig{MinAvailable: &minAvailable},
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		pdb := renderedPDB(g, objs)
+		g.Expect(pdb.Spec.MinAvailable).To(gomega.Equal(&minAvailable))
+		g.Expect(pdb.Spec.MaxUnavailable).To(gomega.BeNil())
+
+		objs, err = renderScaled(mlflowv1.MLflowSpec{
+			Replicas:            ptr(int32(3)),
+			PodDisruptionBudget: &mlflowv1.PDBConfig{Enabled: ptr(true)},
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		pdb = renderedPDB(g, objs)
+		g.Expect(pdb.Spec.MinAvailable).To(gomega.Equal(ptr(intstr.FromInt32(1))))
+		g.Expect(pdb.Spec.MaxUnavailable).To(gomega.BeNil())
+	})
+}
+
+func TestMlflowToHelmValues_DerivedWorkers(t *testing.T) {
+	renderer := &HelmRenderer{}
+
+	cpuRequest := func(cpu string) *corev1.ResourceRequirements {
+		return &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		workers     *int32
+		resources   *corev1.ResourceRequirements
+		wantWorkers int32
+	}{
+		{name: "no resources defaults to 1", wantWorkers: 1},
+		{
+			name: "memory-only request defaults to 1",
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			wantWorkers: 1,
+		},
+		{name: "sub-core request", resources: cpuRequest("250m"), wantWorkers: 1},
+		{name: "half a core", resources: cpuRequest("500m"), wantWorkers: 2},
+		{name: "one core", resources: cpuRequest("1"), wantWorkers: 3},
+		{name: "fractional cores round down", resources: cpuRequest("1500m"), wantWorkers: 4},
+		{name: "two cores", resources: cpuRequest("2"), wantWorkers: 5},
+		{name: "large request is capped", resources: cpuRequest("16"), wantWorkers: maxDerivedWorkers},
+		{name: "explicit workers win", workers: ptr(int32(2)), resources: cpuRequest("4"), wantWorkers: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			values, err := renderer.mlflowToHelmValues(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					Workers:         tt.workers,
+					Resources:       tt.resources,
+				},
+			}, "test-namespace", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(values["mlflow"].(map[string]interface{})["workers"]).To(gomega.Equal(tt.wantWorkers))
+		})
+	}
+}
+
 func TestMlflowToHelmValues_Namespace(t *testing.T) {
 	g := gomega.NewWithT(t)
 	renderer := &HelmRenderer{}
@@ -223,3 +570,610 @@ func TestMlflowToHelmValues_ResourceSuffix(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderChart_MaxRequestHeaderBytes(t *testing.T) {
+	const baseUvicornOpts = "--uvicorn-opts=--ssl-keyfile=/etc/tls/private/tls.key --ssl-certfile=/etc/tls/private/tls.crt --proxy-headers"
+
+	tests := []struct {
+		name     string
+		maxBytes *int32
+		want     string
+	}{
+		{
+			name: "unset keeps the uvicorn default",
+			want: baseUvicornOpts,
+		},
+		{
+			name:     "set raises the h11 limit",
+			maxBytes: ptr(int32(65536)),
+			want:     baseUvicornOpts + " --h11-max-incomplete-event-size=65536",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:       ptr(testBackendStoreURI),
+					MaxRequestHeaderBytes: tt.maxBytes,
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(gomega.ContainElement(tt.want))
+		})
+	}
+}
+
+func TestRenderChart_ServerTimeouts(t *testing.T) {
+	const baseUvicornOpts = "--uvicorn-opts=--ssl-keyfile=/etc/tls/private/tls.key --ssl-certfile=/etc/tls/private/tls.crt --proxy-headers"
+
+	tests := []struct {
+		name     string
+		timeouts *mlflowv1.ServerTimeoutsConfig
+		maxBytes *int32
+		want     string
+	}{
+		{
+			name: "unset keeps the uvicorn defaults",
+			want: baseUvicornOpts,
+		},
+		{
+			name:     "keep-alive raises the idle connection timeout",
+			timeouts: &mlflowv1.ServerTimeoutsConfig{KeepAliveSeconds: ptr(int32(120))},
+			want:     baseUvicornOpts + " --timeout-keep-alive=120",
+		},
+		{
+			name: "both timeouts compose with the header limit",
+			timeouts: &mlflowv1.ServerTimeoutsConfig{
+				KeepAliveSeconds:        ptr(int32(120)),
+				GracefulShutdownSeconds: ptr(int32(20)),
+			},
+			maxBytes: ptr(int32(65536)),
+			want:     baseUvicornOpts + " --h11-max-incomplete-event-size=65536 --timeout-keep-alive=120 --timeout-graceful-shutdown=20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:       ptr(testBackendStoreURI),
+					Workers:               ptr(int32(4)),
+					MaxRequestHeaderBytes: tt.maxBytes,
+					ServerTimeouts:        tt.timeouts,
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			args := deployment.Spec.Template.Spec.Containers[0].Args
+			g.Expect(args).To(gomega.ContainElement(tt.want))
+			g.Expect(args).To(gomega.ContainElement("--workers=4"))
+		})
+	}
+}
+
+func TestRenderChart_AccessLog(t *testing.T) {
+	const baseUvicornOpts = "--uvicorn-opts=--ssl-keyfile=/etc/tls/private/tls.key --ssl-certfile=/etc/tls/private/tls.crt --proxy-headers"
+
+	tests := []struct {
+		name    string
+		logging *mlflowv1.LoggingConfig
+		want    string
+	}{
+		{
+			name: "unset keeps the uvicorn default",
+			want: baseUvicornOpts,
+		},
+		{
+			name:    "access log unset keeps the uvicorn default",
+			logging: &mlflowv1.LoggingConfig{},
+			want:    baseUvicornOpts,
+		},
+		{
+			name:    "enabled writes access logs",
+			logging: &mlflowv1.LoggingConfig{AccessLog: ptr(true)},
+			want:    baseUvicornOpts + " --access-log",
+		},
+		{
+			name:    "disabled turns access logs off",
+			logging: &mlflowv1.LoggingConfig{AccessLog: ptr(false)},
+			want:    baseUvicornOpts + " --no-access-log",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI: ptr(testBackendStoreURI),
+					Logging:         tt.logging,
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(gomega.ContainElement(tt.want))
+		})
+	}
+}
+
+func TestRenderChart_ExtraArgs(t *testing.T) {
+	g := gomega.NewWithT(t)
+	extraArgs := []string{"--gunicorn-opts=--timeout 120", "--dev"}
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			ExtraArgs:       extraArgs,
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	args := deployment.Spec.Template.Spec.Containers[0].Args
+	g.Expect(len(args)).To(gomega.BeNumerically(">", len(extraArgs)))
+	g.Expect(args[len(args)-len(extraArgs):]).To(gomega.Equal(extraArgs))
+	g.Expect(args[:len(args)-len(extraArgs)]).To(gomega.ContainElement("--allowed-hosts"))
+}
+
+func TestRenderChart_SchedulingGates(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.SchedulingGates).To(gomega.BeEmpty())
+
+	gates := []corev1.PodSchedulingGate{{Name: "example.com/database-ready"}}
+	mlflow.Spec.SchedulingGates = gates
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err = renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.SchedulingGates).To(gomega.Equal(gates))
+
+	// The migration Job needs the same dependency, so it keeps the gates.
+	job, err := buildMigrationJobFromDeployment(mlflow, deployment, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.SchedulingGates).To(gomega.Equal(gates))
+}
+
+func TestRenderChart_PriorityClassName(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deploymentObj := findObject(objs, deploymentKind, "mlflow")
+	g.Expect(deploymentObj).NotTo(gomega.BeNil())
+	podSpec, _, _ := unstructured.NestedMap(deploymentObj.Object, "spec", "template", "spec")
+	g.Expect(podSpec).NotTo(gomega.HaveKey("priorityClassName"))
+
+	mlflow.Spec.PriorityClassName = ptr("platform-critical")
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.PriorityClassName).To(gomega.Equal("platform-critical"))
+
+	// The migration Job inherits the pod spec, so it runs at the same priority.
+	job, err := buildMigrationJobFromDeployment(mlflow, deployment, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.PriorityClassName).To(gomega.Equal("platform-critical"))
+}
+
+func TestRenderChart_TerminationGracePeriodSeconds(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.TerminationGracePeriodSeconds).To(gomega.BeNil(),
+		"the Kubernetes default should apply when unset")
+
+	mlflow.Spec.TerminationGracePeriodSeconds = ptr(int64(60))
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err = renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.TerminationGracePeriodSeconds).To(gomega.Equal(ptr(int64(60))))
+
+	// Migration Jobs are not drained, so they keep the default.
+	job, err := buildMigrationJobFromDeployment(mlflow, deployment, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.TerminationGracePeriodSeconds).To(gomega.BeNil())
+}
+
+func TestRenderChart_HostnameAndSubdomain(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deploymentObj := findObject(objs, deploymentKind, "mlflow")
+	g.Expect(deploymentObj).NotTo(gomega.BeNil())
+	podSpec, _, _ := unstructured.NestedMap(deploymentObj.Object, "spec", "template", "spec")
+	g.Expect(podSpec).NotTo(gomega.HaveKey("hostname"))
+	g.Expect(podSpec).NotTo(gomega.HaveKey("subdomain"))
+
+	mlflow.Spec.Hostname = ptr("tracking")
+	mlflow.Spec.Subdomain = ptr("mlflow-headless")
+	mlflow.Spec.Service = &mlflowv1.ServiceSpec{Headless: ptr(true)}
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.Hostname).To(gomega.Equal("tracking"))
+	g.Expect(deployment.Spec.Template.Spec.Subdomain).To(gomega.Equal("mlflow-headless"))
+	g.Expect(findObject(objs, "Service", "mlflow-headless")).NotTo(gomega.BeNil(), "subdomain should name the headless Service")
+
+	// The migration Job does not claim the server's stable DNS name.
+	job, err := buildMigrationJobFromDeployment(mlflow, deployment, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.Hostname).To(gomega.BeEmpty())
+	g.Expect(job.Spec.Template.Spec.Subdomain).To(gomega.BeEmpty())
+}
+
+func TestRenderChart_HostAliases(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:   ptr(testBackendStoreURI),
+			GarbageCollection: &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"},
+			TraceArchival:     &mlflowv1.TraceArchivalSpec{Enabled: true, Schedule: ptr("*/5 * * * *")},
+		},
+	}
+
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	podSpec, _, _ := unstructured.NestedMap(findObject(objs, deploymentKind, "mlflow").Object, "spec", "template", "spec")
+	g.Expect(podSpec).NotTo(gomega.HaveKey("hostAliases"))
+
+	hostAliases := []corev1.HostAlias{{IP: "10.0.0.15", Hostnames: []string{"postgres.example.internal"}}}
+	mlflow.Spec.HostAliases = hostAliases
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	// Host aliases are pod-wide, so the init containers resolve them too.
+	g.Expect(deployment.Spec.Template.Spec.HostAliases).To(gomega.Equal(hostAliases))
+
+	// The migration Job reaches the same database.
+	job, err := buildMigrationJobFromDeployment(mlflow, deployment, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.HostAliases).To(gomega.Equal(hostAliases))
+
+	for _, name := range []string{"mlflow-gc", "mlflow-trace-archival"} {
+		cronJob := findObject(objs, "CronJob", name)
+		g.Expect(cronJob).NotTo(gomega.BeNil(), name)
+		aliases, found, err := unstructured.NestedSlice(cronJob.Object,
+			"spec", "jobTemplate", "spec", "template", "spec", "hostAliases")
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(found).To(gomega.BeTrue(), name)
+		g.Expect(aliases).To(gomega.Equal([]interface{}{map[string]interface{}{
+			"ip":        "10.0.0.15",
+			"hostnames": []interface{}{"postgres.example.internal"},
+		}}), name)
+	}
+}
+
+func TestRenderChart_ShutdownDelay(t *testing.T) {
+	tests := []struct {
+		name          string
+		shutdownDelay *int32
+		wantPreStop   []interface{}
+	}{
+		{
+			name:        "defaults to a short preStop delay",
+			wantPreStop: []interface{}{"sleep", "5"},
+		},
+		{
+			name:          "uses configured delay",
+			shutdownDelay: ptr(int32(15)),
+			wantPreStop:   []interface{}{"sleep", "15"},
+		},
+		{
+			name:          "zero disables the preStop hook",
+			shutdownDelay: ptr(int32(0)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:      ptr(testBackendStoreURI),
+					ShutdownDelaySeconds: tt.shutdownDelay,
+				},
+			}, "test-ns", RenderOptions{}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment := findObject(objs, deploymentKind, "mlflow")
+			g.Expect(deployment).NotTo(gomega.BeNil())
+			containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			command, found, err := unstructured.NestedSlice(containers[0].(map[string]interface{}), "lifecycle", "preStop", "exec", "command")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			if tt.wantPreStop == nil {
+				g.Expect(found).To(gomega.BeFalse())
+				return
+			}
+			g.Expect(command).To(gomega.Equal(tt.wantPreStop))
+		})
+	}
+}
+
+func TestRenderChart_TerminationMessagePolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *corev1.TerminationMessagePolicy
+		want   string
+	}{
+		{
+			name: "defaults to FallbackToLogsOnError",
+			want: string(corev1.TerminationMessageFallbackToLogsOnError),
+		},
+		{
+			name:   "override to File",
+			policy: ptr(corev1.TerminationMessageReadFile),
+			want:   string(corev1.TerminationMessageReadFile),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+				Spec: mlflowv1.MLflowSpec{
+					BackendStoreURI:          ptr(testBackendStoreURI),
+					DefaultExperiment:        ptr("default-team"),
+					TerminationMessagePolicy: tt.policy,
+					GarbageCollection:        &mlflowv1.GarbageCollectionSpec{Schedule: "0 2 * * 0"},
+					TraceArchival: &mlflowv1.TraceArchivalSpec{
+						Enabled:  true,
+						Schedule: ptr("*/5 * * * *"),
+					},
+				},
+			}, "test-ns", RenderOptions{PlatformTrustedCABundleExists: true}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			podSpecPaths := map[string][]string{
+				deploymentKind: {"spec", "template", "spec"},
+				"CronJob":      {"spec", "jobTemplate", "spec", "template", "spec"},
+			}
+			checked := 0
+			for _, obj := range objs {
+				path, ok := podSpecPaths[obj.GetKind()]
+				if !ok {
+					continue
+				}
+				for _, field := range []string{"initContainers", "containers"} {
+					containers, _, err := unstructured.NestedSlice(obj.Object, append(path, field)...)
+					g.Expect(err).NotTo(gomega.HaveOccurred())
+					for _, c := range containers {
+						container := c.(map[string]interface{})
+						g.Expect(container["terminationMessagePolicy"]).To(gomega.Equal(tt.want),
+							"%s %s container %s", obj.GetKind(), obj.GetName(), container["name"])
+						checked++
+					}
+				}
+			}
+			// Deployment: 2 init containers, mlflow and ca-bundle-watcher.
+			// Each CronJob: combine-ca-bundles and its main container.
+			g.Expect(checked).To(gomega.Equal(8))
+
+			deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			job, err := buildMigrationJobFromDeployment(&mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+			}, deployment, "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			for _, container := range append(job.Spec.Template.Spec.InitContainers, job.Spec.Template.Spec.Containers...) {
+				g.Expect(string(container.TerminationMessagePolicy)).To(gomega.Equal(tt.want), "migration container %s", container.Name)
+			}
+		})
+	}
+}
+
+func TestRenderChart_ExtraContainers(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			ExtraContainers: []corev1.Container{{
+				Name:  "fluentbit",
+				Image: "fluent/fluent-bit:3.2",
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+				},
+			}},
+		},
+	}
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns",
+		RenderOptions{PlatformTrustedCABundleExists: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	containers := deployment.Spec.Template.Spec.Containers
+	names := make([]string, 0, len(containers))
+	for _, container := range containers {
+		names = append(names, container.Name)
+	}
+	// The sidecar is appended after the operator-managed containers.
+	g.Expect(names).To(gomega.Equal([]string{"mlflow", "ca-bundle-watcher", "fluentbit"}))
+
+	sidecar := findContainer(containers, "fluentbit")
+	g.Expect(sidecar.Image).To(gomega.Equal("fluent/fluent-bit:3.2"))
+	g.Expect(sidecar.Resources.Limits.Memory().String()).To(gomega.Equal("64Mi"))
+
+	// The migration Job picks the mlflow container by name and leaves sidecars out.
+	job, err := buildMigrationJobFromDeployment(mlflow, deployment, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.Containers).To(gomega.HaveLen(1))
+	g.Expect(job.Spec.Template.Spec.Containers[0].Name).To(gomega.Equal("db-migrate"))
+	g.Expect(job.Spec.Template.Spec.Containers[0].Image).To(gomega.Equal(findContainer(containers, "mlflow").Image))
+}
+
+func TestRenderChart_ServiceAccountToken(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			ExtraContainers: []corev1.Container{
+				{Name: "auth-proxy", Image: "quay.io/brancz/kube-rbac-proxy:v0.18.1"},
+				{Name: "fluentbit", Image: "fluent/fluent-bit:3.2"},
+			},
+		},
+	}
+	tokenMounts := func(container *corev1.Container) []corev1.VolumeMount {
+		var mounts []corev1.VolumeMount
+		for _, mount := range container.VolumeMounts {
+			if mount.MountPath == serviceAccountTokenMountPath {
+				mounts = append(mounts, mount)
+			}
+		}
+		return mounts
+	}
+
+	// By default every container gets the automounted token.
+	objs, err := renderer.RenderChart(mlflow, "test-ns", RenderOptions{PlatformTrustedCABundleExists: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	podSpec := deployment.Spec.Template.Spec
+	g.Expect(podSpec.AutomountServiceAccountToken).To(gomega.Equal(ptr(true)))
+	g.Expect(podSpec.Volumes).NotTo(gomega.ContainElement(gomega.HaveField("Name", serviceAccountTokenVolume)))
+	g.Expect(tokenMounts(findContainer(podSpec.Containers, "mlflow"))).To(gomega.BeEmpty())
+
+	mlflow.Spec.ServiceAccountToken = &mlflowv1.ServiceAccountTokenConfig{Containers: []string{"auth-proxy"}}
+	objs, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{PlatformTrustedCABundleExists: true}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err = renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	podSpec = deployment.Spec.Template.Spec
+	g.Expect(podSpec.AutomountServiceAccountToken).To(gomega.Equal(ptr(false)))
+
+	var volume *corev1.Volume
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == serviceAccountTokenVolume {
+			volume = &podSpec.Volumes[i]
+		}
+	}
+	g.Expect(volume).NotTo(gomega.BeNil())
+	g.Expect(volume.Projected).NotTo(gomega.BeNil())
+	g.Expect(volume.Projected.Sources).To(gomega.HaveLen(3))
+	g.Expect(volume.Projected.Sources[0].ServiceAccountToken).NotTo(gomega.BeNil())
+	g.Expect(volume.Projected.Sources[0].ServiceAccountToken.Path).To(gomega.Equal("token"))
+	g.Expect(volume.Projected.Sources[1].ConfigMap).NotTo(gomega.BeNil())
+	g.Expect(volume.Projected.Sources[1].ConfigMap.Name).To(gomega.Equal("kube-root-ca.crt"))
+	g.Expect(volume.Projected.Sources[2].DownwardAPI).NotTo(gomega.BeNil())
+
+	want := []corev1.VolumeMount{{Name: serviceAccountTokenVolume, MountPath: serviceAccountTokenMountPath, ReadOnly: true}}
+	// The MLflow server authenticates requests against the API server and the
+	// listed proxy issues SubjectAccessReviews, so both keep the token.
+	g.Expect(tokenMounts(findContainer(podSpec.Containers, "mlflow"))).To(gomega.Equal(want))
+	g.Expect(tokenMounts(findContainer(podSpec.Containers, "auth-proxy"))).To(gomega.Equal(want))
+	g.Expect(tokenMounts(findContainer(podSpec.Containers, "fluentbit"))).To(gomega.BeEmpty())
+	g.Expect(tokenMounts(findContainer(podSpec.Containers, "ca-bundle-watcher"))).To(gomega.BeEmpty())
+	g.Expect(podSpec.InitContainers).NotTo(gomega.BeEmpty())
+	for i := range podSpec.InitContainers {
+		g.Expect(tokenMounts(&podSpec.InitContainers[i])).To(gomega.BeEmpty(), podSpec.InitContainers[i].Name)
+	}
+
+	mlflow.Spec.ServiceAccountToken.Containers = []string{"missing"}
+	_, err = renderer.RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(`"missing" is not a spec.extraContainers entry`)))
+}
+
+func TestMlflowToHelmValues_SecurityContext(t *testing.T) {
+	g := gomega.NewWithT(t)
+	values, err := (&HelmRenderer{}).mlflowToHelmValues(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			PodSecurityContext: &corev1.PodSecurityContext{
+				FSGroup:   ptr(int64(1001)),
+				RunAsUser: ptr(int64(1001)),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			},
+			SecurityContext: &corev1.SecurityContext{
+				RunAsNonRoot:           ptr(true),
+				ReadOnlyRootFilesystem: ptr(true),
+			},
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// Values are plain maps keyed by the Kubernetes JSON field names.
+	podSecurityContext, ok := values["podSecurityContext"].(map[string]interface{})
+	g.Expect(ok).To(gomega.BeTrue(), "podSecurityContext should be a map, got %T", values["podSecurityContext"])
+	g.Expect(podSecurityContext).To(gomega.HaveKeyWithValue("fsGroup", int64(1001)))
+	g.Expect(podSecurityContext).To(gomega.HaveKeyWithValue("runAsUser", int64(1001)))
+	g.Expect(podSecurityContext).To(gomega.HaveKeyWithValue("seccompProfile", map[string]interface{}{"type": "RuntimeDefault"}))
+
+	securityContext, ok := values["securityContext"].(map[string]interface{})
+	g.Expect(ok).To(gomega.BeTrue(), "securityContext should be a map, got %T", values["securityContext"])
+	g.Expect(securityContext).To(gomega.HaveKeyWithValue("runAsNonRoot", true))
+	g.Expect(securityContext).To(gomega.HaveKeyWithValue("readOnlyRootFilesystem", true))
+}
+
+func TestRenderChart_PodSecurityContext(t *testing.T) {
+	g := gomega.NewWithT(t)
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:    ptr(testBackendStoreURI),
+			PodSecurityContext: &corev1.PodSecurityContext{FSGroup: ptr(int64(1001))},
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.SecurityContext).NotTo(gomega.BeNil())
+	g.Expect(deployment.Spec.Template.Spec.SecurityContext.FSGroup).To(gomega.Equal(ptr(int64(1001))))
+}
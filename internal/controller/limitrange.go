@@ -0,0 +1,179 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const limitRangeConflictReason = "LimitRangeConflict"
+
+// ContainerResourceBounds is the tightest per-container minimum and maximum
+// across the LimitRanges of a namespace.
+type ContainerResourceBounds struct {
+	Min corev1.ResourceList
+	Max corev1.ResourceList
+}
+
+// defaultMLflowResources returns the MLflow container resources from the
+// chart's values.yaml, which apply when spec.resources is unset.
+func defaultMLflowResources() corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("4"),
+			corev1.ResourceMemory: resource.MustParse("3Gi"),
+		},
+	}
+}
+
+// getContainerResourceBounds merges the Container limits of every LimitRange
+// in namespace. It returns nil when no LimitRange sets a container min or max.
+func (r *MLflowReconciler) getContainerResourceBounds(ctx context.Context, namespace string) (*ContainerResourceBounds, error) {
+	limitRanges := &corev1.LimitRangeList{}
+	if err := r.List(ctx, limitRanges, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("list LimitRanges in %s: %w", namespace, err)
+	}
+
+	bounds := &ContainerResourceBounds{Min: corev1.ResourceList{}, Max: corev1.ResourceList{}}
+	for _, limitRange := range limitRanges.Items {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != corev1.LimitTypeContainer {
+				continue
+			}
+			for name, quantity := range item.Min {
+				if current, ok := bounds.Min[name]; !ok || quantity.Cmp(current) > 0 {
+					bounds.Min[name] = quantity.DeepCopy()
+				}
+			}
+			for name, quantity := range item.Max {
+				if current, ok := bounds.Max[name]; !ok || quantity.Cmp(current) < 0 {
+					bounds.Max[name] = quantity.DeepCopy()
+				}
+			}
+		}
+	}
+	if len(bounds.Min) == 0 && len(bounds.Max) == 0 {
+		return nil, nil
+	}
+	return bounds, nil
+}
+
+// fitResourcesToBounds clamps every request and limit into bounds and lowers
+// requests that end up above their limit.
+func fitResourcesToBounds(resources corev1.ResourceRequirements, bounds *ContainerResourceBounds) corev1.ResourceRequirements {
+	fitted := *resources.DeepCopy()
+	for _, list := range []corev1.ResourceList{fitted.Requests, fitted.Limits} {
+		for name, quantity := range list {
+			if minimum, ok := bounds.Min[name]; ok && quantity.Cmp(minimum) < 0 {
+				quantity = minimum.DeepCopy()
+			}
+			if maximum, ok := bounds.Max[name]; ok && quantity.Cmp(maximum) > 0 {
+				quantity = maximum.DeepCopy()
+			}
+			list[name] = quantity
+		}
+	}
+	for name, request := range fitted.Requests {
+		if limit, ok := fitted.Limits[name]; ok && request.Cmp(limit) > 0 {
+			fitted.Requests[name] = limit.DeepCopy()
+		}
+	}
+	return fitted
+}
+
+// limitRangeViolations lists the MLflow container requests and limits that a
+// LimitRange would reject. User-set spec.resources are checked as given; the
+// chart defaults are checked after fitResourcesToBounds, so they only
+// conflict when the LimitRange minimum exceeds its maximum.
+func limitRangeViolations(mlflow *mlflowv1.MLflow, bounds *ContainerResourceBounds) []string {
+	if bounds == nil {
+		return nil
+	}
+	resources := fitResourcesToBounds(defaultMLflowResources(), bounds)
+	if mlflow.Spec.Resources != nil {
+		resources = *mlflow.Spec.Resources
+	}
+
+	var violations []string
+	check := func(field string, list corev1.ResourceList) {
+		names := make([]string, 0, len(list))
+		for name := range list {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			quantity := list[corev1.ResourceName(name)]
+			if minimum, ok := bounds.Min[corev1.ResourceName(name)]; ok && quantity.Cmp(minimum) < 0 {
+				violations = append(violations, fmt.Sprintf("%s.%s %s is below the minimum %s",
+					field, name, quantity.String(), minimum.String()))
+			}
+			if maximum, ok := bounds.Max[corev1.ResourceName(name)]; ok && quantity.Cmp(maximum) > 0 {
+				violations = append(violations, fmt.Sprintf("%s.%s %s is above the maximum %s",
+					field, name, quantity.String(), maximum.String()))
+			}
+		}
+	}
+	check("requests", resources.Requests)
+	check("limits", resources.Limits)
+	return violations
+}
+
+// limitRangeCause returns the Degraded cause when the namespace LimitRange
+// would reject the MLflow container resources, or nil.
+func limitRangeCause(mlflow *mlflowv1.MLflow, bounds *ContainerResourceBounds) *degradedCause {
+	violations := limitRangeViolations(mlflow, bounds)
+	if len(violations) == 0 {
+		return nil
+	}
+	return &degradedCause{
+		reason: limitRangeConflictReason,
+		message: fmt.Sprintf("MLflow container resources conflict with a LimitRange in the namespace: %s. "+
+			"Pods will be rejected at admission; adjust spec.resources or the LimitRange.",
+			strings.Join(violations, "; ")),
+	}
+}
+
+// limitRangeToMLflowRequests maps LimitRange changes to all MLflow instances.
+func (r *MLflowReconciler) limitRangeToMLflowRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	mlflowList := &mlflowv1.MLflowList{}
+	if err := r.List(ctx, mlflowList); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list MLflow instances for LimitRange watch")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(mlflowList.Items))
+	for _, mlflow := range mlflowList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: mlflow.Name}})
+	}
+	return requests
+}
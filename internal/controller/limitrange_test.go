@@ -0,0 +1,222 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func testLimitRange(name string, limitType corev1.LimitType, minimum, maximum corev1.ResourceList) *corev1.LimitRange {
+	return &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{{Type: limitType, Min: minimum, Max: maximum}},
+		},
+	}
+}
+
+func renderedMLflowResources(t *testing.T, mlflow *mlflowv1.MLflow, opts RenderOptions) corev1.ResourceRequirements {
+	t.Helper()
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", opts, nil)
+	if err != nil {
+		t.Fatalf("RenderChart() error = %v", err)
+	}
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	if err != nil {
+		t.Fatalf("renderedDeployment() error = %v", err)
+	}
+	container := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow")
+	if container == nil {
+		t.Fatal("mlflow container not rendered")
+	}
+	return container.Resources
+}
+
+func TestGetContainerResourceBounds(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []client.Object
+		want    *ContainerResourceBounds
+	}{
+		{
+			name: "no LimitRange",
+		},
+		{
+			name: "pod limits are ignored",
+			objects: []client.Object{
+				testLimitRange("pods", corev1.LimitTypePod, nil, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}),
+			},
+		},
+		{
+			name: "tightest bounds across LimitRanges",
+			objects: []client.Object{
+				testLimitRange("a", corev1.LimitTypeContainer,
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+					corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")}),
+				testLimitRange("b", corev1.LimitTypeContainer,
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+					corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi"), corev1.ResourceCPU: resource.MustParse("2")}),
+			},
+			want: &ContainerResourceBounds{
+				Min: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				Max: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi"), corev1.ResourceCPU: resource.MustParse("2")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			reconciler := &MLflowReconciler{
+				Client: fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(tt.objects...).Build(),
+			}
+
+			bounds, err := reconciler.getContainerResourceBounds(context.Background(), "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			if tt.want == nil {
+				g.Expect(bounds).To(gomega.BeNil())
+				return
+			}
+			g.Expect(bounds).NotTo(gomega.BeNil())
+			g.Expect(equality.Semantic.DeepEqual(bounds.Min, tt.want.Min)).To(gomega.BeTrue(), "min = %v", bounds.Min)
+			g.Expect(equality.Semantic.DeepEqual(bounds.Max, tt.want.Max)).To(gomega.BeTrue(), "max = %v", bounds.Max)
+		})
+	}
+}
+
+func TestRenderChart_DefaultResourcesMatchChart(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+
+	resources := renderedMLflowResources(t, mlflow, RenderOptions{})
+	g.Expect(equality.Semantic.DeepEqual(resources, defaultMLflowResources())).To(gomega.BeTrue(),
+		"defaultMLflowResources() must match charts/mlflow/values.yaml, rendered %v", resources)
+}
+
+func TestRenderChart_FitsDefaultResourcesToLimitRange(t *testing.T) {
+	g := gomega.NewWithT(t)
+	bounds := &ContainerResourceBounds{
+		Min: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		Max: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+	}
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+
+	resources := renderedMLflowResources(t, mlflow, RenderOptions{ContainerResourceBounds: bounds})
+	g.Expect(resources.Requests.Cpu().String()).To(gomega.Equal("2"))
+	g.Expect(resources.Limits.Cpu().String()).To(gomega.Equal("4"))
+	g.Expect(resources.Requests.Memory().String()).To(gomega.Equal("1Gi"))
+	g.Expect(resources.Limits.Memory().String()).To(gomega.Equal("1Gi"))
+
+	// Explicit spec.resources are rendered as given.
+	mlflow.Spec.Resources = &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+		Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+	}
+	resources = renderedMLflowResources(t, mlflow, RenderOptions{ContainerResourceBounds: bounds})
+	g.Expect(equality.Semantic.DeepEqual(resources, *mlflow.Spec.Resources)).To(gomega.BeTrue(), "rendered %v", resources)
+}
+
+func TestSetLimitRangeCondition(t *testing.T) {
+	tests := []struct {
+		name         string
+		resources    *corev1.ResourceRequirements
+		bounds       *ContainerResourceBounds
+		wantConflict bool
+		wantMessage  string
+	}{
+		{
+			name: "no LimitRange",
+		},
+		{
+			name: "defaults are fitted",
+			bounds: &ContainerResourceBounds{
+				Max: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+		},
+		{
+			name: "explicit resources above the maximum",
+			resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+			},
+			bounds: &ContainerResourceBounds{
+				Max: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+			},
+			wantConflict: true,
+			wantMessage:  "limits.memory 8Gi is above the maximum 4Gi",
+		},
+		{
+			name: "explicit resources below the minimum",
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			},
+			bounds: &ContainerResourceBounds{
+				Min: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+			},
+			wantConflict: true,
+			wantMessage:  "requests.cpu 100m is below the minimum 250m",
+		},
+		{
+			name: "minimum above maximum cannot be fitted",
+			bounds: &ContainerResourceBounds{
+				Min: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+				Max: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+			},
+			wantConflict: true,
+			wantMessage:  "is below the minimum 8Gi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Generation: 2},
+				Spec:       mlflowv1.MLflowSpec{Resources: tt.resources},
+			}
+
+			g.Expect(setSpecDegradedCondition(mlflow, limitRangeCause(mlflow, tt.bounds))).To(gomega.Equal(tt.wantConflict))
+			condition := meta.FindStatusCondition(mlflow.Status.Conditions, degradedConditionType)
+			if !tt.wantConflict {
+				g.Expect(condition).To(gomega.BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(gomega.BeNil())
+			g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+			g.Expect(condition.Reason).To(gomega.Equal(limitRangeConflictReason))
+			g.Expect(condition.ObservedGeneration).To(gomega.Equal(int64(2)))
+			g.Expect(condition.Message).To(gomega.ContainSubstring(tt.wantMessage))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Values of the result label on the operator metrics.
+const (
+	metricsResultSuccess = "success"
+	metricsResultRequeue = "requeue"
+	metricsResultError   = "error"
+)
+
+var (
+	// reconcilesTotal counts MLflow reconciles by result.
+	reconcilesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mlflow_operator_reconciles_total",
+			Help: "Total number of MLflow reconciles, by result (success, requeue, error).",
+		},
+		[]string{"result"},
+	)
+
+	// renderDurationSeconds observes how long rendering the MLflow Helm chart takes.
+	renderDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "mlflow_operator_render_duration_seconds",
+			Help:    "Time taken to render the MLflow Helm chart, by result (success, error).",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"result"},
+	)
+
+	// renderErrorsTotal counts failed MLflow Helm chart renders.
+	renderErrorsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "mlflow_operator_render_errors_total",
+			Help: "Total number of failed MLflow Helm chart renders.",
+		},
+	)
+)
+
+func init() {
+	// The controller-runtime registry is served on the manager's metrics
+	// endpoint next to the built-in controller metrics.
+	metrics.Registry.MustRegister(reconcilesTotal, renderDurationSeconds, renderErrorsTotal)
+}
+
+// reconcileResultLabel maps a reconcile outcome to the result label.
+func reconcileResultLabel(result ctrl.Result, err error) string {
+	switch {
+	case err != nil:
+		return metricsResultError
+	case result.RequeueAfter > 0:
+		return metricsResultRequeue
+	default:
+		return metricsResultSuccess
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// renderCount returns the number of render duration observations for result.
+func renderCount(t *testing.T, result string) uint64 {
+	t.Helper()
+	metric := &dto.Metric{}
+	observer := renderDurationSeconds.WithLabelValues(result)
+	if err := observer.(prometheus.Histogram).Write(metric); err != nil {
+		t.Fatalf("read render duration histogram: %v", err)
+	}
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestRenderChartMetrics(t *testing.T) {
+	g := gomega.NewWithT(t)
+	renderer := NewHelmRenderer("../../charts/mlflow")
+
+	successes := renderCount(t, metricsResultSuccess)
+	failures := renderCount(t, metricsResultError)
+	renderErrors := testutil.ToFloat64(renderErrorsTotal)
+
+	_, err := renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(renderCount(t, metricsResultSuccess)).To(gomega.Equal(successes + 1))
+	g.Expect(testutil.ToFloat64(renderErrorsTotal)).To(gomega.Equal(renderErrors))
+
+	// artifactsSubPath without storage is rejected while building values.
+	_, err = renderer.RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI:  ptr(testBackendStoreURI),
+			ServeArtifacts:   ptr(true),
+			ArtifactsSubPath: ptr("team-a"),
+		},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(renderCount(t, metricsResultError)).To(gomega.Equal(failures + 1))
+	g.Expect(testutil.ToFloat64(renderErrorsTotal)).To(gomega.Equal(renderErrors + 1))
+}
+
+func TestReconcileMetrics(t *testing.T) {
+	g := gomega.NewWithT(t)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "missing"}}
+
+	successes := testutil.ToFloat64(reconcilesTotal.WithLabelValues(metricsResultSuccess))
+	reconciler := &MLflowReconciler{
+		Client: fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build(),
+	}
+	_, err := reconciler.Reconcile(context.Background(), req)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(testutil.ToFloat64(reconcilesTotal.WithLabelValues(metricsResultSuccess))).To(gomega.Equal(successes + 1))
+
+	// Without the MLflow type in the scheme the initial Get fails.
+	errorsBefore := testutil.ToFloat64(reconcilesTotal.WithLabelValues(metricsResultError))
+	reconciler = &MLflowReconciler{
+		Client: fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(),
+	}
+	_, err = reconciler.Reconcile(context.Background(), req)
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(testutil.ToFloat64(reconcilesTotal.WithLabelValues(metricsResultError))).To(gomega.Equal(errorsBefore + 1))
+}
+
+func TestOperatorMetricsRegistered(t *testing.T) {
+	g := gomega.NewWithT(t)
+	reconcilesTotal.WithLabelValues(metricsResultSuccess)
+	renderDurationSeconds.WithLabelValues(metricsResultSuccess)
+
+	families, err := metrics.Registry.Gather()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	names := make([]string, 0, len(families))
+	for _, family := range families {
+		names = append(names, family.GetName())
+	}
+	g.Expect(names).To(gomega.ContainElements(
+		"mlflow_operator_reconciles_total",
+		"mlflow_operator_render_duration_seconds",
+		"mlflow_operator_render_errors_total",
+	))
+}
@@ -21,7 +21,9 @@ import (
 	_ "embed"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -36,6 +38,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -45,9 +48,14 @@ import (
 )
 
 const (
-	forceMigrateAnnotation            = "mlflow.opendatahub.io/force-migrate"
-	migrationConditionType            = "Migration"
-	migrationJobContainerName         = "db-migrate"
+	forceMigrateAnnotation    = "mlflow.opendatahub.io/force-migrate"
+	migrationConditionType    = "Migration"
+	migrationJobContainerName = "db-migrate"
+	mlflowContainerName       = "mlflow"
+	// mainContainerAnnotation names the MLflow server container on the
+	// rendered Deployment, so the migration Job can copy it without relying
+	// on the container order or a hardcoded name.
+	mainContainerAnnotation           = "mlflow.opendatahub.io/main-container"
 	MigrationJobLabelKey              = "mlflow.opendatahub.io/migration-job"
 	migrationJobInstanceLabel         = "mlflow.opendatahub.io/migration-instance"
 	supportedVersionEnvName           = "SUPPORTED_MLFLOW_VERSION"
@@ -56,6 +64,7 @@ const (
 	migrationRetryDelay               = 2 * time.Minute
 	migrationRetryDeleteDelay         = 2 * time.Second
 	migrationJobCommand               = `exec python3.12 -c "$MIGRATION_PYTHON_SCRIPT"`
+	portableMigrationJobCommand       = `exec "$(command -v python3.12 || command -v python3)" -c "$MIGRATION_PYTHON_SCRIPT"`
 	migrationJobBackoffLimit          = int32(3)
 	migrationJobTTLSeconds            = int32(24 * 60 * 60) // 24 hours
 
@@ -65,6 +74,9 @@ const (
 	migrationScriptExitCodeRevisionMismatch    = 13
 	migrationScriptExitCodeRevisionResolution  = 14
 	migrationScriptExitCodeRetryableFailure    = 15
+	// migrationExitCodeTimeout is the exit code timeout(1) uses when the
+	// wrapped migration command runs past spec.migration.timeoutSeconds.
+	migrationExitCodeTimeout = 124
 
 	migrationReasonSucceeded             = "MigrationSucceeded"
 	migrationReasonFailed                = "MigrationFailed"
@@ -84,6 +96,10 @@ var versionKeyPattern = regexp.MustCompile(`[^a-zA-Z0-9]+`)
 // SupportedMLflowVersion is injected via -ldflags from config/component_metadata.yaml.
 var SupportedMLflowVersion string
 
+// python3MigrationBaseline is the oldest MLflow image version known to ship the
+// python3.12 interpreter used by the default migration command.
+var python3MigrationBaseline = semver.MustParse("3.10.0")
+
 //go:embed assets/mlflow_db_migrate.py
 var migrationPythonScript string
 
@@ -167,6 +183,12 @@ func migrationRequested(mlflow *mlflowv1.MLflow) bool {
 	return false
 }
 
+// migrationEnabled reports whether the operator-managed migration flow runs
+// for mlflow. It is on unless spec.migration.enabled is explicitly false.
+func migrationEnabled(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Spec.Migration == nil || mlflow.Spec.Migration.Enabled == nil || *mlflow.Spec.Migration.Enabled
+}
+
 func migrationMode(mlflow *mlflowv1.MLflow) mlflowv1.MLflowMigrateMode {
 	if mlflow.Spec.Migration == nil || mlflow.Spec.Migration.Mode == "" {
 		return mlflowv1.MLflowMigrateAutomatic
@@ -218,6 +240,14 @@ func renderedDeployment(objects []*unstructured.Unstructured, name, namespace st
 	return nil, fmt.Errorf("rendered Deployment %s/%s not found", namespace, name)
 }
 
+// migrationObjects returns the rendered objects applied while a migration is
+// pending: spec.unmanagedFields are released as in a normal reconcile, and the
+// MLflow Deployment is scaled to zero even when spec.replicas is unmanaged.
+func migrationObjects(mlflow *mlflowv1.MLflow, namespace string, objects []*unstructured.Unstructured) []*unstructured.Unstructured {
+	deploymentName := ResourceName + getResourceSuffix(mlflow.Name)
+	return scaledDownObjects(withoutUnmanagedFields(mlflow, namespace, objects), deploymentName)
+}
+
 func scaledDownObjects(objects []*unstructured.Unstructured, deploymentName string) []*unstructured.Unstructured {
 	scaled := make([]*unstructured.Unstructured, 0, len(objects))
 	for _, obj := range objects {
@@ -263,6 +293,8 @@ func migrationFailureMessageForExitCode(exitCode int32) (string, bool) {
 		return "migration failed because Alembic could not resolve the schema revision graph", true
 	case migrationScriptExitCodeRetryableFailure:
 		return "migration failed due to a retryable database or migration error", true
+	case migrationExitCodeTimeout:
+		return "migration did not finish within spec.migration.timeoutSeconds; check for database lock contention", true
 	default:
 		return "", false
 	}
@@ -486,6 +518,15 @@ func (r *MLflowReconciler) clearForceMigrateAnnotation(ctx context.Context, mlfl
 	)
 }
 
+// clearMigrationCondition drops the Migration condition once the migration
+// flow is disabled, so a stale in-progress or failed state is not reported.
+func (r *MLflowReconciler) clearMigrationCondition(ctx context.Context, mlflow *mlflowv1.MLflow) error {
+	if !meta.RemoveStatusCondition(&mlflow.Status.Conditions, migrationConditionType) {
+		return nil
+	}
+	return r.updateStatus(ctx, mlflow)
+}
+
 func (r *MLflowReconciler) markMigrationSuccessful(ctx context.Context, mlflow *mlflowv1.MLflow) error {
 	if err := r.clearForceMigrateAnnotation(ctx, mlflow); err != nil {
 		return err
@@ -516,20 +557,37 @@ func (r *MLflowReconciler) markMigrationSuccessful(ctx context.Context, mlflow *
 	return r.updateStatus(ctx, mlflow)
 }
 
-func buildMigrationLabels(templateLabels map[string]string, mlflowName string) map[string]string {
-	labels := make(map[string]string, len(templateLabels)+3)
+func buildMigrationLabels(templateLabels, extraLabels map[string]string, mlflowName string) map[string]string {
+	labels := make(map[string]string, len(templateLabels)+len(extraLabels)+3)
 	for key, value := range templateLabels {
 		if key == "app" {
 			continue
 		}
 		labels[key] = value
 	}
+	for key, value := range extraLabels {
+		// An "app" label would put the Job pod behind the MLflow Service and
+		// the Deployment selector.
+		if reservedPodLabels[key] {
+			continue
+		}
+		labels[key] = value
+	}
 	labels["component"] = "mlflow-migration"
 	labels[MigrationJobLabelKey] = "true"
 	labels[migrationJobInstanceLabel] = mlflowName
 	return labels
 }
 
+// migrationJobMetadata returns the user-configured spec.migration labels and
+// annotations for the migration Job and its pod.
+func migrationJobMetadata(mlflow *mlflowv1.MLflow) (map[string]string, map[string]string) {
+	if mlflow.Spec.Migration == nil {
+		return nil, nil
+	}
+	return mlflow.Spec.Migration.Labels, mlflow.Spec.Migration.Annotations
+}
+
 // classifyMigrationFailure returns true when a failed migration should be
 // treated as terminal rather than automatically retried by the operator.
 func classifyMigrationFailure(details migrationFailureDetails) bool {
@@ -543,22 +601,91 @@ func migrationJobTTLSecondsAfterFinished(mlflow *mlflowv1.MLflow) int32 {
 	return migrationJobTTLSeconds
 }
 
+// migrationJobResources returns the spec.migration.resources override when set,
+// otherwise the resources inherited from the MLflow server container.
+func migrationJobResources(mlflow *mlflowv1.MLflow, inherited corev1.ResourceRequirements) (corev1.ResourceRequirements, error) {
+	if mlflow.Spec.Migration == nil || mlflow.Spec.Migration.Resources == nil {
+		return inherited, nil
+	}
+	resources := *mlflow.Spec.Migration.Resources.DeepCopy()
+
+	names := make([]string, 0, len(resources.Requests))
+	for name := range resources.Requests {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		request := resources.Requests[corev1.ResourceName(name)]
+		limit, ok := resources.Limits[corev1.ResourceName(name)]
+		if ok && request.Cmp(limit) > 0 {
+			return corev1.ResourceRequirements{}, fmt.Errorf(
+				"spec.migration.resources: %s request %s must not exceed limit %s", name, request.String(), limit.String(),
+			)
+		}
+	}
+	return resources, nil
+}
+
+// mlflowVersionFromImage returns the MLflow version encoded in a semver-like
+// image tag such as "v3.10.1+rhaiv.3", or nil when the image is referenced by
+// digest only or by a non-version tag such as "latest".
+func mlflowVersionFromImage(image string) *semver.Version {
+	_, tag, _ := splitImage(image)
+	if tag == "" {
+		return nil
+	}
+	version, err := semver.NewVersion(tag)
+	if err != nil {
+		return nil
+	}
+	return version
+}
+
+// migrationJobCommandForImage selects the migration command for the MLflow
+// version parsed from the image tag. Images older than the python3.12 baseline
+// probe for an interpreter so the script can report a clear version mismatch
+// instead of failing with a missing-binary error. Untagged or non-semver images
+// use the default command.
+func migrationJobCommandForImage(image string) string {
+	version := mlflowVersionFromImage(image)
+	if version != nil && version.LessThan(python3MigrationBaseline) {
+		return portableMigrationJobCommand
+	}
+	return migrationJobCommand
+}
+
+// migrationJobCommandWithTimeout wraps the migration command with timeout(1)
+// when spec.migration.timeoutSeconds is set, so a hung attempt exits with
+// migrationExitCodeTimeout instead of blocking the rollout.
+func migrationJobCommandWithTimeout(mlflow *mlflowv1.MLflow, command string) string {
+	if mlflow.Spec.Migration == nil || mlflow.Spec.Migration.TimeoutSeconds == nil {
+		return command
+	}
+	return fmt.Sprintf("exec timeout %d %s", *mlflow.Spec.Migration.TimeoutSeconds, strings.TrimPrefix(command, "exec "))
+}
+
 func buildMigrationJobFromDeployment(mlflow *mlflowv1.MLflow, deployment *appsv1.Deployment, namespace string) (*batchv1.Job, error) {
-	mainContainer := findContainer(deployment.Spec.Template.Spec.Containers, "mlflow")
-	if mainContainer == nil {
-		return nil, fmt.Errorf("rendered Deployment %s/%s does not have an mlflow container", namespace, deployment.Name)
+	mainContainer, err := findMainContainer(deployment)
+	if err != nil {
+		return nil, fmt.Errorf("rendered Deployment %s/%s: %w", namespace, deployment.Name, err)
 	}
 
 	podSpec := deployment.Spec.Template.Spec.DeepCopy()
 	jobContainer := mainContainer.DeepCopy()
 	jobContainer.Name = migrationJobContainerName
 	jobContainer.Command = []string{"/bin/sh", "-ec"}
-	jobContainer.Args = []string{migrationJobCommand}
+	jobContainer.Args = []string{migrationJobCommandWithTimeout(mlflow, migrationJobCommandForImage(jobContainer.Image))}
 	jobContainer.Ports = nil
 	jobContainer.LivenessProbe = nil
 	jobContainer.ReadinessProbe = nil
 	jobContainer.StartupProbe = nil
 	jobContainer.Lifecycle = nil
+	jobContainer.VolumeMounts = migrationVolumeMounts(mlflow, jobContainer.VolumeMounts)
+	resources, err := migrationJobResources(mlflow, jobContainer.Resources)
+	if err != nil {
+		return nil, err
+	}
+	jobContainer.Resources = resources
 	jobContainer.Resources.Claims = nil
 	jobContainer.Env = filterEnvVar(jobContainer.Env, readReplicaBackendStoreURIEnvName)
 	jobContainer.Env = append(jobContainer.Env, corev1.EnvVar{
@@ -577,24 +704,30 @@ func buildMigrationJobFromDeployment(mlflow *mlflowv1.MLflow, deployment *appsv1
 	podSpec.Containers = []corev1.Container{*jobContainer}
 	podSpec.InitContainers = filterMigrationInitContainers(podSpec.InitContainers)
 	podSpec.ResourceClaims = nil
+	// The stable pod DNS name belongs to the server pods.
+	podSpec.Hostname = ""
+	podSpec.Subdomain = ""
 	podSpec.Volumes = filterVolumes(podSpec.Volumes, usedVolumeNames(*podSpec))
 	podSpec.RestartPolicy = corev1.RestartPolicyNever
 	podSpec.TerminationGracePeriodSeconds = nil
 
 	backoffLimit := migrationJobBackoffLimit
 	ttlSecondsAfterFinished := migrationJobTTLSecondsAfterFinished(mlflow)
+	extraLabels, annotations := migrationJobMetadata(mlflow)
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      migrationJobName(mlflow),
-			Namespace: namespace,
-			Labels:    buildMigrationLabels(deployment.Spec.Template.Labels, mlflow.Name),
+			Name:        migrationJobName(mlflow),
+			Namespace:   namespace,
+			Labels:      buildMigrationLabels(deployment.Spec.Template.Labels, extraLabels, mlflow.Name),
+			Annotations: maps.Clone(annotations),
 		},
 		Spec: batchv1.JobSpec{
 			BackoffLimit:            &backoffLimit,
 			TTLSecondsAfterFinished: &ttlSecondsAfterFinished,
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: buildMigrationLabels(deployment.Spec.Template.Labels, mlflow.Name),
+					Labels:      buildMigrationLabels(deployment.Spec.Template.Labels, extraLabels, mlflow.Name),
+					Annotations: maps.Clone(annotations),
 				},
 				Spec: *podSpec,
 			},
@@ -607,6 +740,35 @@ func deploymentHasActiveReplicas(deployment *appsv1.Deployment) bool {
 	return deployment.Status.Replicas > 0
 }
 
+// findMainContainer returns the MLflow server container of deployment: the
+// container named by the main-container annotation, else the container named
+// mlflow, else the only container of the pod.
+func findMainContainer(deployment *appsv1.Deployment) (*corev1.Container, error) {
+	containers := deployment.Spec.Template.Spec.Containers
+	if name, ok := deployment.Annotations[mainContainerAnnotation]; ok {
+		if container := findContainer(containers, name); container != nil {
+			return container, nil
+		}
+		return nil, fmt.Errorf("%s annotation names container %q, but the pod only has containers %v",
+			mainContainerAnnotation, name, containerNames(containers))
+	}
+	if container := findContainer(containers, mlflowContainerName); container != nil {
+		return container, nil
+	}
+	if len(containers) == 1 {
+		return &containers[0], nil
+	}
+	return nil, fmt.Errorf("cannot identify the MLflow container among %v", containerNames(containers))
+}
+
+func containerNames(containers []corev1.Container) []string {
+	names := make([]string, 0, len(containers))
+	for _, container := range containers {
+		names = append(names, container.Name)
+	}
+	return names
+}
+
 func findContainer(containers []corev1.Container, name string) *corev1.Container {
 	for i := range containers {
 		if containers[i].Name == name {
@@ -626,6 +788,33 @@ func filterEnvVar(env []corev1.EnvVar, excludedName string) []corev1.EnvVar {
 	return filtered
 }
 
+// migrationVolumeMounts drops the spec.extraVolumeMounts entries that are not
+// allowlisted in spec.migration.extraVolumeMounts. Mounts are matched on name
+// and path, so operator-managed mounts of the same volume are kept.
+func migrationVolumeMounts(mlflow *mlflowv1.MLflow, mounts []corev1.VolumeMount) []corev1.VolumeMount {
+	if len(mlflow.Spec.ExtraVolumeMounts) == 0 {
+		return mounts
+	}
+	allowed := sets.New[string]()
+	if mlflow.Spec.Migration != nil {
+		allowed.Insert(mlflow.Spec.Migration.ExtraVolumeMounts...)
+	}
+	excluded := sets.New[string]()
+	for _, mount := range mlflow.Spec.ExtraVolumeMounts {
+		if !allowed.Has(mount.Name) {
+			excluded.Insert(mount.Name + ":" + mount.MountPath)
+		}
+	}
+
+	filtered := make([]corev1.VolumeMount, 0, len(mounts))
+	for _, mount := range mounts {
+		if !excluded.Has(mount.Name + ":" + mount.MountPath) {
+			filtered = append(filtered, mount)
+		}
+	}
+	return filtered
+}
+
 func filterMigrationInitContainers(initContainers []corev1.Container) []corev1.Container {
 	filtered := make([]corev1.Container, 0, len(initContainers))
 	for _, initContainer := range initContainers {
@@ -682,6 +871,9 @@ func (r *MLflowReconciler) handleMigration(ctx context.Context, mlflow *mlflowv1
 			"generation", mlflow.Generation,
 		)
 	}
+	if !migrationEnabled(mlflow) {
+		return ctrl.Result{}, false, r.clearMigrationCondition(ctx, mlflow)
+	}
 	if !migrationRequested(mlflow) {
 		return ctrl.Result{}, false, nil
 	}
@@ -711,7 +903,7 @@ func (r *MLflowReconciler) handleMigration(ctx context.Context, mlflow *mlflowv1
 	}
 
 	if jobExists && isJobFailed(existingJob) && !hasForceMigrateAnnotation(mlflow) {
-		if err := r.applyRenderedObjects(ctx, mlflow, scaledDownObjects(objects, deploymentName)); err != nil {
+		if err := r.applyRenderedObjects(ctx, mlflow, migrationObjects(mlflow, namespace, objects)); err != nil {
 			return ctrl.Result{}, true, err
 		}
 
@@ -825,7 +1017,7 @@ func (r *MLflowReconciler) handleMigration(ctx context.Context, mlflow *mlflowv1
 	// Any path that reaches here either has no finished migration Job yet or is
 	// intentionally holding the Deployment at zero replicas while migration is
 	// pending or failed.
-	if err := r.applyRenderedObjects(ctx, mlflow, scaledDownObjects(objects, deploymentName)); err != nil {
+	if err := r.applyRenderedObjects(ctx, mlflow, migrationObjects(mlflow, namespace, objects)); err != nil {
 		return ctrl.Result{}, true, err
 	}
 
@@ -893,6 +1085,7 @@ func (r *MLflowReconciler) handleMigration(ctx context.Context, mlflow *mlflowv1
 		if err := controllerutil.SetControllerReference(mlflow, job, r.Scheme); err != nil {
 			return ctrl.Result{}, true, err
 		}
+		setSourceAnnotations(mlflow, job)
 		if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
 			return ctrl.Result{}, true, err
 		}
@@ -122,6 +122,7 @@ var _ = Describe("Migration reconcile", func() {
 		Expect(*deployment.Spec.Replicas).To(Equal(int32(1)))
 		deployment.Status.Replicas = 1
 		deployment.Status.ReadyReplicas = 1
+		deployment.Status.AvailableReplicas = 1
 		Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
 
 		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: resourceName}})
@@ -184,6 +185,7 @@ var _ = Describe("Migration reconcile", func() {
 		Expect(k8sClient.Get(ctx, deploymentKey, deployment)).To(Succeed())
 		deployment.Status.Replicas = 1
 		deployment.Status.ReadyReplicas = 1
+		deployment.Status.AvailableReplicas = 1
 		Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
 
 		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: resourceName}})
@@ -388,6 +390,42 @@ var _ = Describe("Migration reconcile", func() {
 		Expect(migrationCondition.Reason).To(Equal("MigrationFailed"))
 	})
 
+	It("starts MLflow without a migration Job when migration is disabled", func() {
+		ctx := context.Background()
+		namespace := "migration-disabled"
+		Expect(k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+		DeferCleanup(func() {
+			_ = k8sClient.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})
+			_ = k8sClient.Delete(ctx, &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: resourceName}})
+		})
+
+		mlflow := newMLflow()
+		mlflow.Spec.Migration = &mlflowv1.MLflowMigrationConfig{Enabled: ptr(false)}
+		Expect(k8sClient.Create(ctx, mlflow)).To(Succeed())
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, mlflow)).To(Succeed())
+		mlflow.SetMigrationProgress(migrationReasonRunning, "stale progress from an earlier generation")
+		Expect(k8sClient.Status().Update(ctx, mlflow)).To(Succeed())
+
+		reconciler := newReconciler(namespace)
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: resourceName}})
+		Expect(err).NotTo(HaveOccurred())
+
+		job := &batchv1.Job{}
+		jobKey := types.NamespacedName{Name: migrationJobName(mlflow), Namespace: namespace}
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, jobKey, job))).To(BeTrue())
+
+		deployment := &appsv1.Deployment{}
+		deploymentKey := types.NamespacedName{Name: ResourceName, Namespace: namespace}
+		Expect(k8sClient.Get(ctx, deploymentKey, deployment)).To(Succeed())
+		Expect(deployment.Spec.Replicas).NotTo(BeNil())
+		Expect(*deployment.Spec.Replicas).To(Equal(int32(1)))
+
+		updatedMLflow := &mlflowv1.MLflow{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName}, updatedMLflow)).To(Succeed())
+		Expect(updatedMLflow.Status.Version).To(BeEmpty())
+		Expect(apimeta.FindStatusCondition(updatedMLflow.Status.Conditions, migrationConditionType)).To(BeNil())
+	})
+
 	It("falls back to the Job condition message when no migration pod status is available", func() {
 		ctx := context.Background()
 		namespace := "migration-failure-fallback"
@@ -28,8 +28,12 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -174,6 +178,31 @@ func TestMigrationRequested(t *testing.T) {
 	}
 }
 
+func TestMigrationEnabled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		migration *mlflowv1.MLflowMigrationConfig
+		want      bool
+	}{
+		{name: "enabled when migration is unset", want: true},
+		{name: "enabled when enabled is unset", migration: &mlflowv1.MLflowMigrationConfig{Mode: mlflowv1.MLflowMigrateAlways}, want: true},
+		{name: "enabled when explicitly true", migration: &mlflowv1.MLflowMigrationConfig{Enabled: ptr(true)}, want: true},
+		{name: "disabled when explicitly false", migration: &mlflowv1.MLflowMigrationConfig{Enabled: ptr(false)}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mlflow := &mlflowv1.MLflow{Spec: mlflowv1.MLflowSpec{Migration: tt.migration}}
+			if got := migrationEnabled(mlflow); got != tt.want {
+				t.Fatalf("migrationEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMigrationConditionWasForceTriggered(t *testing.T) {
 	t.Parallel()
 
@@ -362,6 +391,235 @@ func TestBuildMigrationJobFromDeployment(t *testing.T) {
 	g.Expect(*job.Spec.TTLSecondsAfterFinished).To(gomega.Equal(customTTL))
 }
 
+func TestFindMainContainer(t *testing.T) {
+	deploymentWith := func(annotations map[string]string, names ...string) *appsv1.Deployment {
+		containers := make([]corev1.Container, 0, len(names))
+		for _, name := range names {
+			containers = append(containers, corev1.Container{Name: name})
+		}
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Annotations: annotations},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: containers}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		want       string
+		wantErr    string
+	}{
+		{
+			name:       "annotation names a renamed container",
+			deployment: deploymentWith(map[string]string{mainContainerAnnotation: "server"}, "log-exporter", "server"),
+			want:       "server",
+		},
+		{
+			name:       "annotation names a missing container",
+			deployment: deploymentWith(map[string]string{mainContainerAnnotation: "server"}, "mlflow"),
+			wantErr:    `names container "server", but the pod only has containers [mlflow]`,
+		},
+		{
+			name:       "mlflow container without the annotation",
+			deployment: deploymentWith(nil, "ca-bundle-watcher", "mlflow"),
+			want:       "mlflow",
+		},
+		{
+			name:       "only container is used as a fallback",
+			deployment: deploymentWith(nil, "tracking-server"),
+			want:       "tracking-server",
+		},
+		{
+			name:       "several unknown containers",
+			deployment: deploymentWith(nil, "tracking-server", "log-exporter"),
+			wantErr:    "cannot identify the MLflow container among [tracking-server log-exporter]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			container, err := findMainContainer(tt.deployment)
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(container.Name).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestBuildMigrationJobFromDeploymentRenamedContainer(t *testing.T) {
+	g := gomega.NewWithT(t)
+	objs, err := NewHelmRenderer("../../charts/mlflow").RenderChart(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec:       mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}, "test-ns", RenderOptions{}, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	deployment, err := renderedDeployment(objs, "mlflow", "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deployment.Annotations).To(gomega.HaveKeyWithValue(mainContainerAnnotation, mlflowContainerName))
+
+	// A chart that renames the server container keeps the annotation in sync.
+	findContainer(deployment.Spec.Template.Spec.Containers, mlflowContainerName).Name = "tracking-server"
+	deployment.Annotations[mainContainerAnnotation] = "tracking-server"
+	job, err := buildMigrationJobFromDeployment(&mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}, deployment, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.Containers).To(gomega.HaveLen(1))
+	g.Expect(job.Spec.Template.Spec.Containers[0].Name).To(gomega.Equal(migrationJobContainerName))
+	g.Expect(job.Spec.Template.Spec.Containers[0].Image).To(gomega.Equal(deployment.Spec.Template.Spec.Containers[0].Image))
+
+	// Without the annotation the error names the containers it saw.
+	delete(deployment.Annotations, mainContainerAnnotation)
+	deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{Name: "log-exporter"})
+	_, err = buildMigrationJobFromDeployment(&mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}, deployment, "test-ns")
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("rendered Deployment test-ns/mlflow: cannot identify the MLflow container")))
+}
+
+func TestBuildMigrationJobFromDeploymentResources(t *testing.T) {
+	serverResources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("3Gi"),
+		},
+		Claims: []corev1.ResourceClaim{{Name: "shared-gpu"}},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "mlflow"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:      "mlflow",
+						Image:     "quay.io/opendatahub/mlflow:odh-stable",
+						Resources: serverResources,
+					}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		resources *corev1.ResourceRequirements
+		want      corev1.ResourceRequirements
+		wantErr   string
+	}{
+		{
+			name: "inherits server container resources without claims",
+			want: corev1.ResourceRequirements{
+				Requests: serverResources.Requests,
+				Limits:   serverResources.Limits,
+			},
+		},
+		{
+			name: "uses migration resources override",
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				Claims: []corev1.ResourceClaim{{Name: "shared-gpu"}},
+			},
+			want: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+			},
+		},
+		{
+			name: "rejects requests above limits",
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			wantErr: "memory request 4Gi must not exceed limit 1Gi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}
+			if tt.resources != nil {
+				mlflow.Spec.Migration = &mlflowv1.MLflowMigrationConfig{Resources: tt.resources}
+			}
+
+			job, err := buildMigrationJobFromDeployment(mlflow, deployment.DeepCopy(), "test-ns")
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(job.Spec.Template.Spec.Containers[0].Resources).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestBuildMigrationJobFromDeploymentMetadata(t *testing.T) {
+	g := gomega.NewWithT(t)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+					"app":  "mlflow",
+					"team": "ml-platform",
+				}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "mlflow", Image: "quay.io/opendatahub/mlflow:odh-stable"}},
+				},
+			},
+		},
+	}
+
+	job, err := buildMigrationJobFromDeployment(&mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			Migration: &mlflowv1.MLflowMigrationConfig{
+				Labels: map[string]string{
+					"cost-center": "data-platform",
+					"team":        "db-admins",
+					"component":   "custom",
+					"app":         "mlflow",
+				},
+				Annotations: map[string]string{
+					"example.com/job-type": "schema-migration",
+				},
+			},
+		},
+	}, deployment, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	for _, meta := range []metav1.ObjectMeta{job.ObjectMeta, job.Spec.Template.ObjectMeta} {
+		g.Expect(meta.Labels).To(gomega.HaveKeyWithValue("cost-center", "data-platform"))
+		g.Expect(meta.Labels).To(gomega.HaveKeyWithValue("team", "db-admins"))
+		g.Expect(meta.Labels).To(gomega.HaveKeyWithValue("component", "mlflow-migration"))
+		g.Expect(meta.Labels).To(gomega.HaveKeyWithValue(MigrationJobLabelKey, "true"))
+		g.Expect(meta.Labels).To(gomega.HaveKeyWithValue(migrationJobInstanceLabel, "mlflow"))
+		g.Expect(meta.Labels).NotTo(gomega.HaveKey("app"))
+		g.Expect(meta.Annotations).To(gomega.Equal(map[string]string{
+			"example.com/job-type": "schema-migration",
+		}))
+	}
+}
+
 func TestSupportedVersionEarlierThanStatusVersion(t *testing.T) {
 	t.Parallel()
 
@@ -403,6 +661,112 @@ func TestSupportedVersionEarlierThanStatusVersion(t *testing.T) {
 	}
 }
 
+func TestMigrationJobCommandForImage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		image       string
+		wantVersion string
+		want        string
+	}{
+		{
+			name:        "current release tag uses default command",
+			image:       "quay.io/opendatahub/mlflow:v3.10.1",
+			wantVersion: "3.10.1",
+			want:        migrationJobCommand,
+		},
+		{
+			name:        "build metadata and digest are ignored",
+			image:       "registry.example.com:5000/mlflow/mlflow:3.11.0+rhaiv.3@sha256:abc123",
+			wantVersion: "3.11.0+rhaiv.3",
+			want:        migrationJobCommand,
+		},
+		{
+			name:        "older release tag uses portable interpreter",
+			image:       "quay.io/opendatahub/mlflow:v3.4.0",
+			wantVersion: "3.4.0",
+			want:        portableMigrationJobCommand,
+		},
+		{
+			name:  "non-semver tag falls back to default command",
+			image: "quay.io/opendatahub/mlflow:odh-stable",
+			want:  migrationJobCommand,
+		},
+		{
+			name:  "registry port is not mistaken for a tag",
+			image: "registry.example.com:5000/mlflow/mlflow",
+			want:  migrationJobCommand,
+		},
+		{
+			name:  "digest-only reference falls back to default command",
+			image: "quay.io/opendatahub/mlflow@sha256:abc123",
+			want:  migrationJobCommand,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			version := mlflowVersionFromImage(tt.image)
+			switch {
+			case tt.wantVersion == "" && version != nil:
+				t.Fatalf("mlflowVersionFromImage(%q) = %v, want nil", tt.image, version)
+			case tt.wantVersion != "" && (version == nil || version.String() != tt.wantVersion):
+				t.Fatalf("mlflowVersionFromImage(%q) = %v, want %s", tt.image, version, tt.wantVersion)
+			}
+			if got := migrationJobCommandForImage(tt.image); got != tt.want {
+				t.Fatalf("migrationJobCommandForImage(%q) = %q, want %q", tt.image, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrationJobCommandWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		migration *mlflowv1.MLflowMigrationConfig
+		command   string
+		want      string
+	}{
+		{
+			name:    "no migration config leaves the command unwrapped",
+			command: migrationJobCommand,
+			want:    migrationJobCommand,
+		},
+		{
+			name:      "unset timeout leaves the command unwrapped",
+			migration: &mlflowv1.MLflowMigrationConfig{},
+			command:   migrationJobCommand,
+			want:      migrationJobCommand,
+		},
+		{
+			name:      "timeout wraps the default command",
+			migration: &mlflowv1.MLflowMigrationConfig{TimeoutSeconds: ptr(int32(600))},
+			command:   migrationJobCommand,
+			want:      `exec timeout 600 python3.12 -c "$MIGRATION_PYTHON_SCRIPT"`,
+		},
+		{
+			name:      "timeout wraps the portable command",
+			migration: &mlflowv1.MLflowMigrationConfig{TimeoutSeconds: ptr(int32(30))},
+			command:   portableMigrationJobCommand,
+			want:      `exec timeout 30 "$(command -v python3.12 || command -v python3)" -c "$MIGRATION_PYTHON_SCRIPT"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mlflow := &mlflowv1.MLflow{Spec: mlflowv1.MLflowSpec{Migration: tt.migration}}
+			if got := migrationJobCommandWithTimeout(mlflow, tt.command); got != tt.want {
+				t.Fatalf("migrationJobCommandWithTimeout() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsJobFailedRequiresTerminalFailureCondition(t *testing.T) {
 	t.Parallel()
 
@@ -530,6 +894,12 @@ func TestMigrationFailureMessageForExitCode(t *testing.T) {
 			want:     "migration failed due to a retryable database or migration error",
 			ok:       true,
 		},
+		{
+			name:     "timeout",
+			exitCode: migrationExitCodeTimeout,
+			want:     "migration did not finish within spec.migration.timeoutSeconds; check for database lock contention",
+			ok:       true,
+		},
 		{
 			name:     "unknown",
 			exitCode: 99,
@@ -577,6 +947,11 @@ func TestClassifyMigrationFailure(t *testing.T) {
 			details: migrationFailureDetails{exitCode: migrationScriptExitCodeRetryableFailure, hasExitCode: true, message: "retryable"},
 			want:    false,
 		},
+		{
+			name:    "timeout remains retryable",
+			details: migrationFailureDetails{exitCode: migrationExitCodeTimeout, hasExitCode: true, message: "timeout"},
+			want:    false,
+		},
 		{
 			name:    "job condition failure remains retryable without exit code",
 			details: migrationFailureDetails{message: "job condition failure message"},
@@ -749,3 +1124,49 @@ func TestDeploymentHasActiveReplicas(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleMigrationReleasesUnmanagedFields(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow", UID: "mlflow-uid", Generation: 1},
+		Spec: mlflowv1.MLflowSpec{
+			BackendStoreURI: ptr(testBackendStoreURI),
+			NodeSelector:    map[string]string{"disk": "ssd"},
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&mlflowv1.MLflow{}).
+		WithObjects(mlflow).
+		Build()
+	r := &MLflowReconciler{Client: c, Scheme: scheme}
+
+	render := func() []*unstructured.Unstructured {
+		objects, err := NewHelmRenderer("../../charts/mlflow").RenderChart(mlflow, "test-ns", RenderOptions{}, nil)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		return objects
+	}
+	g.Expect(r.applyObject(ctx, findObject(render(), deploymentKind, "mlflow"))).To(gomega.Succeed())
+
+	// Another controller takes over the node selector.
+	g.Expect(c.Patch(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "mlflow"}},
+		client.RawPatch(types.MergePatchType, []byte(`{"spec":{"template":{"spec":{"nodeSelector":{"disk":"hdd"}}}}}`)),
+		client.FieldOwner("node-placer"))).To(gomega.Succeed())
+
+	mlflow.Spec.UnmanagedFields = []string{"spec.replicas", "spec.template.spec.nodeSelector"}
+	objects := []*unstructured.Unstructured{findObject(render(), deploymentKind, "mlflow")}
+	_, handled, err := r.handleMigration(ctx, mlflow, "test-ns", objects)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(handled).To(gomega.BeTrue())
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Namespace: "test-ns", Name: "mlflow"}, deployment)).To(gomega.Succeed())
+	g.Expect(deployment.Spec.Template.Spec.NodeSelector).To(gomega.HaveKeyWithValue("disk", "hdd"))
+	// The Deployment is still scaled to zero for the migration.
+	g.Expect(deployment.Spec.Replicas).To(gomega.HaveValue(gomega.Equal(int32(0))))
+}
@@ -19,13 +19,20 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	consolev1 "github.com/openshift/api/console/v1"
+	routev1 "github.com/openshift/api/route/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -55,8 +62,18 @@ import (
 
 const (
 	chartPath = "charts/mlflow"
+
+	// Every object the operator controls is stamped with the UID and
+	// generation of the MLflow CR that last applied it, so tooling can
+	// trace a resource back to the exact CR instance and spec version.
+	sourceUIDAnnotation        = "mlflow.opendatahub.io/source-uid"
+	sourceGenerationAnnotation = "mlflow.opendatahub.io/source-generation"
 )
 
+// OperatorVersion is injected via -ldflags at build time and reported in
+// status.operatorVersion.
+var OperatorVersion string
+
 // MLflowReconciler reconciles a MLflow object
 type MLflowReconciler struct {
 	client.Client
@@ -66,7 +83,21 @@ type MLflowReconciler struct {
 	ConsoleLinkAvailable    bool
 	HTTPRouteAvailable      bool
 	ServiceMonitorAvailable bool
+	RouteAvailable          bool
 	GCRBACWatchCache        crcache.Cache
+
+	// ReferencedObjectWatchCache holds the metadata of every Secret and
+	// ConfigMap in the target namespace, so changes to the objects the spec
+	// references are seen even though they lack the app=mlflow label.
+	ReferencedObjectWatchCache crcache.Cache
+	// APIReader reads referenced Secrets and ConfigMaps, which the label-filtered
+	// manager cache does not hold. Defaults to Client.
+	APIReader client.Reader
+
+	// renderer is created on first use and shared across reconciles so the
+	// chart is parsed once.
+	rendererOnce sync.Once
+	renderer     *HelmRenderer
 }
 
 // +kubebuilder:rbac:groups=config.openshift.io,resources=apiservers,verbs=get;list;watch
@@ -76,6 +107,8 @@ type MLflowReconciler struct {
 // +kubebuilder:rbac:groups="",resources=secrets,resourceNames=mlflow-artifact-connection,verbs=get;list;watch
 // +kubebuilder:rbac:groups=mlflow.kubeflow.org,resources=mlflowconfigs,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 // Shared server RBAC objects are statically named `mlflow` and watched through metadata.name
 // field selectors so list/watch remains compatible with resourceNames-scoped authorization.
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=create
@@ -89,14 +122,23 @@ type MLflowReconciler struct {
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,resourceNames=mlflow-gc,verbs=list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=console.openshift.io,resources=consolelinks,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=route.openshift.io,resources=routes/custom-host,verbs=create
 //
-// Namespace-scoped permissions (serviceaccounts, secrets, services, persistentvolumeclaims, deployments, networkpolicies)
+// Namespace-scoped permissions (serviceaccounts, secrets, services, persistentvolumeclaims, deployments,
+// replicasets, horizontalpodautoscalers, networkpolicies, ingresses, poddisruptionbudgets, limitranges)
 // are granted via the Role in config/rbac/namespace_role.yaml instead of the ClusterRole above.
 // This allows the operator to manage resources in target namespaces where MLflow instances are deployed.
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcile(ctx, req)
+	reconcilesTotal.WithLabelValues(reconcileResultLabel(result, err)).Inc()
+	return result, err
+}
+
+func (r *MLflowReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
 	// Fetch the MLflow instance
@@ -122,6 +164,13 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, nil
 	}
 
+	if paused, err := r.reconcilePaused(ctx, mlflow); err != nil {
+		log.Error(err, "Failed to update MLflow status while paused")
+		return ctrl.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
 	if result, handled, err := r.ensureMLflowOperatorReady(ctx, mlflow, cfg); err != nil {
 		log.Error(err, "MLflowOperator dependency check failed")
 		return ctrl.Result{}, err
@@ -129,64 +178,26 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return result, nil
 	}
 
+	if renderOnly, err := r.reconcileRenderOnly(ctx, mlflow, cfg); err != nil {
+		log.Error(err, "Failed to write the render-only preview")
+		return ctrl.Result{}, err
+	} else if renderOnly {
+		return ctrl.Result{}, nil
+	}
+
 	targetNamespace := cfg.ApplicationsNamespace
-	mlflow.Status.Address = buildStatusAddress(mlflow.Name, targetNamespace)
-
-	// Clean up GC resources when garbage collection is disabled.
-	if mlflow.Spec.GarbageCollection == nil {
-		gcSuffix := "-gc" + getResourceSuffix(mlflow.Name)
-		gcResources := []struct {
-			obj  client.Object
-			kind string
-			name string
-			ns   string
-		}{
-			{&batchv1.CronJob{}, "CronJob", ResourceName + gcSuffix, targetNamespace},
-			{&corev1.ServiceAccount{}, "ServiceAccount", GCServiceAccountName, targetNamespace},
-			{&rbacv1.ClusterRoleBinding{}, "ClusterRoleBinding", ResourceName + gcSuffix, ""},
-			{&rbacv1.ClusterRole{}, "ClusterRole", ResourceName + gcSuffix, ""},
-		}
-		for _, res := range gcResources {
-			existing := res.obj.DeepCopyObject().(client.Object)
-			existing.SetName(res.name)
-			existing.SetNamespace(res.ns)
-			if err := r.Delete(ctx, existing); err != nil {
-				if errors.IsNotFound(err) {
-					continue
-				}
-				log.Error(err, "Failed to delete GC resource", "kind", res.kind, "name", res.name)
-				return ctrl.Result{}, err
-			}
-			log.Info("Deleted GC resource", "kind", res.kind, "name", res.name)
-		}
+	mlflow.Status.Address = buildStatusAddress(mlflow.Name, targetNamespace, mlflowBasePath(mlflow))
+	if setArtifactStoreInsecureCondition(mlflow) {
+		log.Info("WARNING: S3 TLS certificate verification is disabled by spec.artifactStore.s3.insecureSkipVerify; use only for development")
 	}
+	setStorageSizeIgnoredCondition(mlflow)
+	setEphemeralStorageCondition(mlflow)
+	setSQLiteConcurrencyCondition(mlflow)
+	setSQLiteInProductionCondition(mlflow, cfg)
 
-	// Clean up trace archival resources when archival is disabled.
-	if !isTraceArchivalEnabled(mlflow) {
-		taSuffix := "-trace-archival" + getResourceSuffix(mlflow.Name)
-		taResources := []struct {
-			obj  client.Object
-			kind string
-			name string
-			ns   string
-		}{
-			{&batchv1.CronJob{}, "CronJob", ResourceName + taSuffix, targetNamespace},
-			{&corev1.ServiceAccount{}, "ServiceAccount", TraceArchivalServiceAccountName, targetNamespace},
-			{&corev1.ConfigMap{}, "ConfigMap", "mlflow-trace-archival-config" + getResourceSuffix(mlflow.Name), targetNamespace},
-		}
-		for _, res := range taResources {
-			existing := res.obj.DeepCopyObject().(client.Object)
-			existing.SetName(res.name)
-			existing.SetNamespace(res.ns)
-			if err := r.Delete(ctx, existing); err != nil {
-				if errors.IsNotFound(err) {
-					continue
-				}
-				log.Error(err, "Failed to delete trace archival resource", "kind", res.kind, "name", res.name)
-				return ctrl.Result{}, err
-			}
-			log.Info("Deleted trace archival resource", "kind", res.kind, "name", res.name)
-		}
+	// Clean up resources whose feature is disabled.
+	if err := r.deleteDisabledResources(ctx, mlflow, targetNamespace); err != nil {
+		return ctrl.Result{}, err
 	}
 
 	// Validate user-provided CA bundle ConfigMap if specified
@@ -248,19 +259,48 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, fmt.Errorf("%s", msg)
 	}
 
-	// Render the Helm chart
-	helmChartPath := r.ChartPath
-	if helmChartPath == "" {
-		helmChartPath = chartPath
+	resourceBounds, err := r.getContainerResourceBounds(ctx, targetNamespace)
+	if err != nil {
+		log.Error(err, "Failed to read LimitRanges")
+		return ctrl.Result{}, err
 	}
-	renderer := NewHelmRenderer(helmChartPath)
-	renderOpts := RenderOptions{
-		PlatformTrustedCABundleExists: platformCABundleExists,
-		// If ConsoleLink is available, we can assume we are on OpenShift
-		IsOpenShift:             r.ConsoleLinkAvailable,
-		ServiceMonitorAvailable: r.ServiceMonitorAvailable,
+	missingDependency := r.missingDependencyCause(mlflow)
+	if missingDependency != nil {
+		log.Info("MLflow spec enables a feature whose API is not installed", "missing", r.missingDependencies(mlflow))
+	}
+	limitRangeConflict := limitRangeCause(mlflow, resourceBounds)
+	if limitRangeConflict != nil {
+		log.Info("MLflow container resources conflict with a namespace LimitRange", "namespace", targetNamespace)
+	}
+	// The size range is advisory, so a StorageClass lookup failure only
+	// skips the check.
+	storageSizeBounds, err := r.getStorageSizeBounds(ctx, mlflow, targetNamespace)
+	if err != nil {
+		log.Error(err, "Failed to read the allowed storage size range, skipping the check")
+	}
+	storageSizeConflict := storageSizeCause(mlflow, storageSizeBounds)
+	if storageSizeConflict != nil {
+		log.Info("MLflow storage size is outside the allowed range", "violations", storageSizeViolations(mlflow, storageSizeBounds))
+	}
+	// Set Degraded once so every cause is reported, not only the last one.
+	setSpecDegradedCondition(mlflow, missingDependency, limitRangeConflict, storageSizeConflict)
+
+	observedHosts, err := r.observedHosts(ctx, mlflow, targetNamespace)
+	if err != nil {
+		log.Error(err, "Failed to read the assigned Route, Ingress and Service hosts")
+		return ctrl.Result{}, err
 	}
-	objects, err := renderer.RenderChart(mlflow, targetNamespace, renderOpts, cfg)
+
+	referencedDataHash, err := r.referencedDataHash(ctx, mlflow, targetNamespace)
+	if err != nil {
+		log.Error(err, "Failed to read the referenced Secrets and ConfigMaps")
+		return ctrl.Result{}, err
+	}
+
+	// Render the Helm chart
+	renderer := r.chartRenderer()
+	renderOptions := r.renderOptions(platformCABundleExists, resourceBounds, observedHosts, referencedDataHash)
+	objects, err := renderer.RenderChart(mlflow, targetNamespace, renderOptions, cfg)
 	if err != nil {
 		log.Error(err, "Failed to render Helm chart")
 		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
@@ -280,6 +320,17 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}
 		return ctrl.Result{}, err
 	}
+	if chartVersion, err := renderer.ChartVersion(); err == nil {
+		mlflow.Status.ChartVersion = chartVersion
+	}
+	mlflow.Status.OperatorVersion = OperatorVersion
+
+	if result, handled, err := r.reconcileDeploymentSelector(ctx, mlflow, targetNamespace, objects); err != nil {
+		log.Error(err, "Failed to reconcile Deployment selector")
+		return ctrl.Result{}, err
+	} else if handled {
+		return result, nil
+	}
 
 	if result, handled, err := r.handleMigration(ctx, mlflow, targetNamespace, objects); err != nil {
 		log.Error(err, "Failed to reconcile migration")
@@ -291,7 +342,13 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return result, nil
 	}
 
-	if err := r.applyRenderedObjects(ctx, mlflow, objects); err != nil {
+	clientTokenRequeueAfter, err := r.rotateClientToken(ctx, mlflow, targetNamespace)
+	if err != nil {
+		log.Error(err, "Failed to rotate client token")
+		return ctrl.Result{}, err
+	}
+
+	if err := r.applyRenderedObjects(ctx, mlflow, withoutUnmanagedFields(mlflow, targetNamespace, objects)); err != nil {
 		log.Error(err, "Failed to apply rendered objects")
 		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
 			Type:    "Available",
@@ -359,17 +416,8 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
 	}
 
-	// Check if deployment is ready
-	// Get desired replica count from deployment spec
-	desiredReplicas := int32(1)
-	if deployment.Spec.Replicas != nil {
-		desiredReplicas = *deployment.Spec.Replicas
-	}
-
-	// Only mark as ready if:
-	// 1. Desired replicas > 0 (not scaled down)
-	// 2. All desired replicas are ready
-	if desiredReplicas > 0 && deployment.Status.ReadyReplicas >= desiredReplicas {
+	conditions, ready := deploymentConditions(deployment, mlflow.Generation)
+	if ready {
 		migrationJob := &batchv1.Job{}
 		jobErr := r.Get(ctx, types.NamespacedName{Name: migrationJobName(mlflow), Namespace: targetNamespace}, migrationJob)
 		switch {
@@ -382,38 +430,29 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			log.Error(jobErr, "Failed to get migration Job")
 			return ctrl.Result{}, jobErr
 		}
+	}
 
-		// Deployment is ready
-		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
-			Type:    "Available",
-			Status:  metav1.ConditionTrue,
-			Reason:  "DeploymentReady",
-			Message: "MLflow deployment is ready and available",
-		})
-		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
-			Type:    "Progressing",
-			Status:  metav1.ConditionFalse,
-			Reason:  "ReconcileComplete",
-			Message: "MLflow reconciliation completed successfully",
-		})
-	} else {
-		// Deployment not ready yet
-		message := fmt.Sprintf("MLflow deployment not ready: %d/%d replicas ready", deployment.Status.ReadyReplicas, desiredReplicas)
-		if desiredReplicas == 0 {
-			message = "MLflow deployment scaled to zero replicas"
+	// A Deployment selector migration owns the Degraded condition until the
+	// orphaned ReplicaSets are cleaned up, a LimitRange or storage size
+	// conflict explains admission and provisioning failures better than the
+	// Deployment's own conditions, and a missing API stays reported while
+	// the Deployment is otherwise healthy.
+	selectorMigrating := isDeploymentSelectorMigrating(mlflow)
+	for _, condition := range conditions {
+		if condition.Type == degradedConditionType &&
+			(limitRangeConflict != nil || storageSizeConflict != nil ||
+				condition.Status == metav1.ConditionFalse && (selectorMigrating || missingDependency != nil)) {
+			continue
 		}
-		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
-			Type:    "Available",
-			Status:  metav1.ConditionFalse,
-			Reason:  "DeploymentNotReady",
-			Message: message,
-		})
-		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
-			Type:    "Progressing",
-			Status:  metav1.ConditionTrue,
-			Reason:  "DeploymentProgressing",
-			Message: message,
-		})
+		meta.SetStatusCondition(&mlflow.Status.Conditions, condition)
+	}
+
+	if ready {
+		if err := r.cleanupOrphanedReplicaSets(ctx, mlflow, deployment); err != nil {
+			log.Error(err, "Failed to clean up ReplicaSets orphaned by a Deployment selector change")
+			return ctrl.Result{}, err
+		}
+	} else {
 		// Keep requeuing until ready
 		if err := r.updateStatus(ctx, mlflow); err != nil {
 			log.Error(err, "Failed to update MLflow status after retries")
@@ -428,10 +467,49 @@ func (r *MLflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	log.Info("Successfully reconciled MLflow")
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: clientTokenRequeueAfter}, nil
+}
+
+// helmChartPath returns the configured chart path, falling back to the default
+// in-image chart location.
+func (r *MLflowReconciler) helmChartPath() string {
+	if r.ChartPath == "" {
+		return chartPath
+	}
+	return r.ChartPath
+}
+
+// chartRenderer returns the reconciler's HelmRenderer, creating it on first use.
+func (r *MLflowReconciler) chartRenderer() *HelmRenderer {
+	r.rendererOnce.Do(func() {
+		r.renderer = NewHelmRenderer(r.helmChartPath())
+	})
+	return r.renderer
+}
+
+// renderOptions returns the chart render options for the cluster the
+// reconciler runs in.
+func (r *MLflowReconciler) renderOptions(
+	platformCABundleExists bool,
+	resourceBounds *ContainerResourceBounds,
+	observedHosts []string,
+	referencedDataHash string,
+) RenderOptions {
+	return RenderOptions{
+		PlatformTrustedCABundleExists: platformCABundleExists,
+		// If ConsoleLink is available, we can assume we are on OpenShift
+		IsOpenShift:             r.ConsoleLinkAvailable,
+		ServiceMonitorAvailable: r.ServiceMonitorAvailable,
+		RouteAvailable:          r.RouteAvailable,
+		ContainerResourceBounds: resourceBounds,
+		ObservedHosts:           observedHosts,
+		ReferencedDataHash:      referencedDataHash,
+	}
 }
 
-// applyObject applies a single Kubernetes object using Server-Side Apply
+// applyObject applies a single Kubernetes object using Server-Side Apply.
+// The operator only owns the fields present in the rendered object, so labels
+// and annotations added by other field managers survive reconciles.
 func (r *MLflowReconciler) applyObject(ctx context.Context, obj client.Object) error {
 	log := logf.FromContext(ctx)
 
@@ -468,16 +546,25 @@ func (r *MLflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.GCRBACWatchCache == nil {
 		return fmt.Errorf("GCRBACWatchCache must be configured")
 	}
+	if r.ReferencedObjectWatchCache == nil {
+		return fmt.Errorf("ReferencedObjectWatchCache must be configured")
+	}
+	if err := r.chartRenderer().Validate(); err != nil {
+		return fmt.Errorf("invalid MLflow Helm chart: %w", err)
+	}
 
 	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&mlflowv1.MLflow{}).
 		Owns(&appsv1.Deployment{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
 		Owns(&batchv1.Job{}).
 		Owns(&batchv1.CronJob{}).
 		Owns(&corev1.Secret{}).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&networkingv1.Ingress{}).
 		// For shared cluster-scoped RBAC objects, we use Watches instead of Owns because:
 		// 1. The shared objects can have multiple non-controller owner references (one per MLflow instance)
 		// 2. Owns() only triggers on controller owner references
@@ -493,7 +580,9 @@ func (r *MLflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			controllerbuilder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
 				return obj.GetName() == PlatformTrustedCABundleConfigMapName
 			})),
-		)
+		).
+		// Refit the default container resources when a LimitRange changes.
+		Watches(&corev1.LimitRange{}, handler.EnqueueRequestsFromMapFunc(r.limitRangeToMLflowRequests))
 	if config.GetConfig().EnableMLflowOperatorModuleController {
 		builder = builder.Watches(
 			&modulev1alpha1.MLflowOperator{},
@@ -525,6 +614,26 @@ func (r *MLflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			),
 		)
 
+	// Re-reconcile when a Secret or ConfigMap referenced from the spec is created,
+	// updated or deleted. User-owned objects do not carry the app=mlflow label the
+	// main cache filters on, so they are watched through a dedicated cache that
+	// only holds their metadata.
+	builder = builder.
+		WatchesRawSource(
+			source.Kind(
+				r.ReferencedObjectWatchCache,
+				referencedObjectMetadata(corev1.SchemeGroupVersion.WithKind("Secret")),
+				handler.TypedEnqueueRequestsFromMapFunc(r.secretMetadataToReferencingMLflowRequests),
+			),
+		).
+		WatchesRawSource(
+			source.Kind(
+				r.ReferencedObjectWatchCache,
+				referencedObjectMetadata(corev1.SchemeGroupVersion.WithKind("ConfigMap")),
+				handler.TypedEnqueueRequestsFromMapFunc(r.configMapMetadataToReferencingMLflowRequests),
+			),
+		)
+
 	// Conditionally watch ConsoleLink if available in the cluster
 	if r.ConsoleLinkAvailable {
 		log.Info("ConsoleLink CRD available, adding to watch list")
@@ -541,6 +650,14 @@ func (r *MLflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		log.Info("HTTPRoute CRD not available, skipping watch")
 	}
 
+	// Conditionally watch Route if available in the cluster
+	if r.RouteAvailable {
+		log.Info("Route API available, adding to watch list")
+		builder = builder.Owns(&routev1.Route{})
+	} else {
+		log.Info("Route API not available, skipping watch")
+	}
+
 	// Conditionally watch ServiceMonitor if available in the cluster
 	if r.ServiceMonitorAvailable {
 		log.Info("ServiceMonitor CRD available, adding to watch list")
@@ -555,18 +672,8 @@ func (r *MLflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 func (r *MLflowReconciler) applyRenderedObjects(ctx context.Context, mlflow *mlflowv1.MLflow, objects []*unstructured.Unstructured) error {
 	log := logf.FromContext(ctx)
 	for _, obj := range objects {
-		if obj.GetKind() != "Namespace" {
-			if isSharedRBACObject(obj) {
-				if err := r.appendOwnerReference(ctx, mlflow, obj); err != nil {
-					log.Error(err, "Failed to append owner reference", "object", obj.GetKind(), "name", obj.GetName())
-					return fmt.Errorf("append owner reference to %s/%s: %w", obj.GetKind(), obj.GetName(), err)
-				}
-			} else {
-				if err := controllerutil.SetControllerReference(mlflow, obj, r.Scheme); err != nil {
-					log.Error(err, "Failed to set controller reference", "object", obj.GetKind(), "name", obj.GetName())
-					return fmt.Errorf("set controller reference on %s/%s: %w", obj.GetKind(), obj.GetName(), err)
-				}
-			}
+		if err := r.setOwnership(ctx, mlflow, obj); err != nil {
+			return err
 		}
 
 		if err := r.applyObject(ctx, obj); err != nil {
@@ -577,6 +684,287 @@ func (r *MLflowReconciler) applyRenderedObjects(ctx context.Context, mlflow *mlf
 	return nil
 }
 
+// setOwnership sets the owner reference and source annotations that
+// applyRenderedObjects applies with a rendered object.
+func (r *MLflowReconciler) setOwnership(ctx context.Context, mlflow *mlflowv1.MLflow, obj *unstructured.Unstructured) error {
+	log := logf.FromContext(ctx)
+	// MLflow is cluster-scoped, which makes it a valid owner for both
+	// namespaced and cluster-scoped objects, so deleting the CR garbage
+	// collects everything rendered here. The target Namespace is the only
+	// exception: it may hold objects the operator does not manage.
+	if obj.GetKind() == "Namespace" {
+		return nil
+	}
+	if isSharedRBACObject(obj) {
+		if err := r.appendOwnerReference(ctx, mlflow, obj); err != nil {
+			log.Error(err, "Failed to append owner reference", "object", obj.GetKind(), "name", obj.GetName())
+			return fmt.Errorf("append owner reference to %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		return nil
+	}
+	if err := controllerutil.SetControllerReference(mlflow, obj, r.Scheme); err != nil {
+		log.Error(err, "Failed to set controller reference", "object", obj.GetKind(), "name", obj.GetName())
+		return fmt.Errorf("set controller reference on %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	setSourceAnnotations(mlflow, obj)
+	return nil
+}
+
+// deploymentConditions computes the Available, Progressing and Degraded
+// conditions from the state of the managed Deployment. It reports ready when
+// every desired replica is available.
+func deploymentConditions(deployment *appsv1.Deployment, generation int64) ([]metav1.Condition, bool) {
+	desiredReplicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desiredReplicas = *deployment.Spec.Replicas
+	}
+	ready := desiredReplicas > 0 && deployment.Status.AvailableReplicas >= desiredReplicas
+
+	available := metav1.Condition{
+		Type:               "Available",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             "DeploymentReady",
+		Message:            "MLflow deployment is ready and available",
+	}
+	progressing := metav1.Condition{
+		Type:               "Progressing",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             "ReconcileComplete",
+		Message:            "MLflow reconciliation completed successfully",
+	}
+	degraded := metav1.Condition{
+		Type:               degradedConditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             "DeploymentHealthy",
+		Message:            "MLflow deployment has no failed rollout",
+	}
+
+	if !ready {
+		message := fmt.Sprintf("MLflow deployment not ready: %d/%d replicas available",
+			deployment.Status.AvailableReplicas, desiredReplicas)
+		if desiredReplicas == 0 {
+			message = "MLflow deployment scaled to zero replicas"
+		}
+		available.Status = metav1.ConditionFalse
+		available.Reason = "DeploymentNotReady"
+		available.Message = message
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = "DeploymentProgressing"
+		progressing.Message = message
+	}
+
+	// The Deployment controller reports a stuck rollout or pods it cannot
+	// create, for example because of a quota, through its own conditions.
+	for _, condition := range deployment.Status.Conditions {
+		switch {
+		case condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse &&
+			condition.Reason == "ProgressDeadlineExceeded":
+			progressing.Status = metav1.ConditionFalse
+			progressing.Reason = "RolloutFailed"
+			progressing.Message = fmt.Sprintf("MLflow deployment rollout failed: %s", condition.Message)
+			degraded.Status = metav1.ConditionTrue
+			degraded.Reason = "RolloutFailed"
+			degraded.Message = progressing.Message
+		case condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue &&
+			degraded.Status != metav1.ConditionTrue:
+			degraded.Status = metav1.ConditionTrue
+			degraded.Reason = "ReplicaFailure"
+			degraded.Message = fmt.Sprintf("MLflow deployment cannot create pods: %s", condition.Message)
+		}
+	}
+
+	return []metav1.Condition{available, progressing, degraded}, ready
+}
+
+// setArtifactStoreInsecureCondition records an ArtifactStoreInsecure=True
+// warning condition while S3 TLS verification is disabled, and removes it
+// otherwise. It reports whether verification is disabled.
+func setArtifactStoreInsecureCondition(mlflow *mlflowv1.MLflow) bool {
+	if !s3InsecureSkipVerify(mlflow) {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, ArtifactStoreInsecureCondition)
+		return false
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               ArtifactStoreInsecureCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             "S3TLSVerificationDisabled",
+		Message: "WARNING: TLS certificate verification for the S3 artifact store is disabled " +
+			"(spec.artifactStore.s3.insecureSkipVerify). Artifact traffic can be intercepted. " +
+			"Use this only for development; configure caBundleConfigMap instead.",
+	})
+	return true
+}
+
+// setStorageSizeIgnoredCondition records a StorageSizeIgnored=True warning
+// condition while spec.storage sets a size or storage class next to
+// spec.existingStorageClaim, and removes it otherwise. The existing claim is
+// mounted as is, so those fields have no effect.
+func setStorageSizeIgnoredCondition(mlflow *mlflowv1.MLflow) {
+	storage := mlflow.Spec.Storage
+	if mlflow.Spec.ExistingStorageClaim == nil || storage == nil {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, StorageSizeIgnoredCondition)
+		return
+	}
+	var ignored []string
+	if _, ok := storage.Resources.Requests[corev1.ResourceStorage]; ok {
+		ignored = append(ignored, "spec.storage.resources.requests.storage")
+	}
+	if storage.StorageClassName != nil {
+		ignored = append(ignored, "spec.storage.storageClassName")
+	}
+	if len(ignored) == 0 {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, StorageSizeIgnoredCondition)
+		return
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               StorageSizeIgnoredCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             "ExistingClaimInUse",
+		Message: fmt.Sprintf("WARNING: %s is ignored because spec.existingStorageClaim %q is mounted instead of an operator-managed PVC.",
+			strings.Join(ignored, " and "), *mlflow.Spec.ExistingStorageClaim),
+	})
+}
+
+// setEphemeralStorageCondition records an EphemeralStorage=True warning
+// condition while a store or the artifact root is file-based but no storage
+// is configured, and removes it otherwise. CRD validation rejects sqlite://
+// and file:// store URIs without storage, but sqlite+<driver> URIs and
+// serveArtifacts without an artifactsDestination still fall back to the
+// container filesystem, which is lost when the pod restarts. URIs read from
+// Secrets cannot be checked.
+func setEphemeralStorageCondition(mlflow *mlflowv1.MLflow) {
+	spec := &mlflow.Spec
+	if spec.Storage != nil || spec.ExistingStorageClaim != nil {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, EphemeralStorageCondition)
+		return
+	}
+	isFileURI := func(uri *string) bool {
+		return uri != nil && (strings.HasPrefix(*uri, "sqlite") || strings.HasPrefix(*uri, "file://"))
+	}
+	var ephemeral []string
+	if isFileURI(spec.BackendStoreURI) {
+		ephemeral = append(ephemeral, "spec.backendStoreUri")
+	}
+	if isFileURI(spec.RegistryStoreURI) {
+		ephemeral = append(ephemeral, "spec.registryStoreUri")
+	}
+	if spec.ServeArtifacts != nil && *spec.ServeArtifacts {
+		if spec.ArtifactsDestination == nil && spec.ArtifactsDestinationFrom == nil {
+			ephemeral = append(ephemeral, "the default artifactsDestination "+defaultArtifactsDest)
+		} else if isFileURI(spec.ArtifactsDestination) {
+			ephemeral = append(ephemeral, "spec.artifactsDestination")
+		}
+	}
+	if isFileURI(spec.DefaultArtifactRoot) {
+		ephemeral = append(ephemeral, "spec.defaultArtifactRoot")
+	}
+	if len(ephemeral) == 0 {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, EphemeralStorageCondition)
+		return
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               EphemeralStorageCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             "StorageNotConfigured",
+		Message: fmt.Sprintf("WARNING: %s points at the container filesystem, which is lost when the pod restarts. "+
+			"Configure spec.storage or spec.existingStorageClaim, or use a remote store.", strings.Join(ephemeral, " and ")),
+	})
+}
+
+// setSQLiteConcurrencyCondition records a SQLiteConcurrency=True warning
+// condition while the resolved backend or registry store is SQLite and more
+// than one process writes it, and removes it otherwise. CRD validation rejects
+// inline SQLite URIs with several replicas, but the legacy implicit SQLite
+// backend is not covered by admission. Several uvicorn workers in one pod share
+// the file safely but contend for its write lock.
+func setSQLiteConcurrencyCondition(mlflow *mlflowv1.MLflow) {
+	sqlite := !usesRemoteBackendStore(mlflow) ||
+		(mlflow.Spec.RegistryStoreURI != nil && strings.HasPrefix(*mlflow.Spec.RegistryStoreURI, "sqlite"))
+	if !sqlite {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, SQLiteConcurrencyCondition)
+		return
+	}
+	replicas := minimumReplicas(mlflow)
+	if autoscaling := mlflow.Spec.Autoscaling; autoscaling != nil && autoscaling.Enabled != nil && *autoscaling.Enabled {
+		replicas = autoscaling.MaxReplicas
+	}
+	var reason, message string
+	switch workers := defaultWorkers(mlflow); {
+	case replicas > 1:
+		reason = "SQLiteReplicas"
+		message = fmt.Sprintf("WARNING: up to %d replicas write one SQLite store, which corrupts the database. "+
+			"Use a postgresql:// or mysql:// store, or run a single replica.", replicas)
+	case workers > 1:
+		reason = "SQLiteWorkers"
+		message = fmt.Sprintf("WARNING: %d workers write one SQLite store and may fail with \"database is locked\". "+
+			"Use a postgresql:// or mysql:// store, or set spec.workers to 1.", workers)
+	default:
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, SQLiteConcurrencyCondition)
+		return
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               SQLiteConcurrencyCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setSQLiteInProductionCondition sets the SQLiteInProduction warning while an
+// ODH or RHOAI install keeps its backend or registry store in SQLite, which
+// does not hold up to many concurrent users. Standalone installs are not
+// warned, since SQLite is a reasonable default for development.
+func setSQLiteInProductionCondition(mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) {
+	var stores []string
+	if !usesRemoteBackendStore(mlflow) {
+		stores = append(stores, "backend")
+	}
+	if mlflow.Spec.RegistryStoreURI != nil && strings.HasPrefix(*mlflow.Spec.RegistryStoreURI, "sqlite") {
+		stores = append(stores, "registry")
+	}
+	if !cfg.IsProductionMode() || len(stores) == 0 {
+		meta.RemoveStatusCondition(&mlflow.Status.Conditions, SQLiteInProductionCondition)
+		return
+	}
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               SQLiteInProductionCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             "SQLiteStore",
+		Message: fmt.Sprintf("WARNING: the %s store is SQLite, which is unsuitable for production multi-user MLflow "+
+			"in the %s deployment mode. Use a postgresql:// or mysql:// store.",
+			strings.Join(stores, " and "), cfg.DeploymentMode),
+	})
+}
+
+// withoutUnmanagedFields returns the rendered objects with spec.unmanagedFields
+// removed from the MLflow Deployment, so Server-Side Apply releases those fields
+// to other controllers such as a HorizontalPodAutoscaler.
+func withoutUnmanagedFields(mlflow *mlflowv1.MLflow, namespace string, objects []*unstructured.Unstructured) []*unstructured.Unstructured {
+	if len(mlflow.Spec.UnmanagedFields) == 0 {
+		return objects
+	}
+	deploymentName := ResourceName + getResourceSuffix(mlflow.Name)
+	filtered := make([]*unstructured.Unstructured, 0, len(objects))
+	for _, obj := range objects {
+		if obj.GetKind() == "Deployment" && obj.GetName() == deploymentName && obj.GetNamespace() == namespace {
+			obj = obj.DeepCopy()
+			for _, path := range mlflow.Spec.UnmanagedFields {
+				unstructured.RemoveNestedField(obj.Object, strings.Split(path, ".")...)
+			}
+		}
+		filtered = append(filtered, obj)
+	}
+	return filtered
+}
+
 // sharedClusterRoleToMLflowRequests maps the shared ClusterRole to MLflow reconcile requests.
 func (r *MLflowReconciler) sharedClusterRoleToMLflowRequests(ctx context.Context, obj client.Object) []reconcile.Request {
 	return sharedRBACObjectToMLflowRequests(obj, ClusterRoleName)
@@ -667,19 +1055,35 @@ func (r *MLflowReconciler) updateStatus(ctx context.Context, mlflow *mlflowv1.ML
 	})
 }
 
+// setSourceAnnotations records the UID and generation of mlflow on obj.
+// Shared RBAC objects are left out because several MLflow CRs apply them.
+func setSourceAnnotations(mlflow *mlflowv1.MLflow, obj client.Object) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[sourceUIDAnnotation] = string(mlflow.UID)
+	annotations[sourceGenerationAnnotation] = strconv.FormatInt(mlflow.Generation, 10)
+	obj.SetAnnotations(annotations)
+}
+
 // appendOwnerReference appends an owner reference to the object without removing existing ones.
 // This is used for shared resources like ClusterRole and ClusterRoleBinding where multiple MLflow
 // instances may reference the same resource.
 // Unlike SetControllerReference, this allows multiple owners (but none are marked as controller).
+// Each reference still sets blockOwnerDeletion, matching controller references, so foreground
+// deletion of any owning MLflow waits for the garbage collector to release the object.
 // It fetches the existing object from the cluster to preserve owner references from other MLflow instances.
 func (r *MLflowReconciler) appendOwnerReference(ctx context.Context, mlflow *mlflowv1.MLflow, obj client.Object) error {
 	// Build the owner reference for this MLflow instance
 	gvk := mlflowv1.GroupVersion.WithKind("MLflow")
+	blockOwnerDeletion := true
 	ownerRef := metav1.OwnerReference{
-		APIVersion: gvk.GroupVersion().String(),
-		Kind:       gvk.Kind,
-		Name:       mlflow.Name,
-		UID:        mlflow.UID,
+		APIVersion:         gvk.GroupVersion().String(),
+		Kind:               gvk.Kind,
+		Name:               mlflow.Name,
+		UID:                mlflow.UID,
+		BlockOwnerDeletion: &blockOwnerDeletion,
 	}
 
 	// Try to get the existing object from the cluster to preserve its owner references
@@ -697,10 +1101,11 @@ func (r *MLflowReconciler) appendOwnerReference(ctx context.Context, mlflow *mlf
 	// Get existing owner references from the cluster object
 	existingRefs := existing.GetOwnerReferences()
 
-	// Check if this owner reference already exists
-	for _, ref := range existingRefs {
+	// Check if this owner reference already exists. References written before
+	// blockOwnerDeletion was set are refreshed in place.
+	for i, ref := range existingRefs {
 		if ref.UID == ownerRef.UID {
-			// Already exists, set the existing refs on the object to apply
+			existingRefs[i] = ownerRef
 			obj.SetOwnerReferences(existingRefs)
 			return nil
 		}
@@ -742,6 +1147,18 @@ func isSharedRBACObject(obj client.Object) bool {
 	}
 }
 
+// NewReferencedObjectWatchCache returns a cache for the metadata of the
+// Secrets and ConfigMaps in namespace. Unlike the manager cache it is not
+// filtered by label, because the objects an MLflow spec references are
+// usually created by users.
+func NewReferencedObjectWatchCache(cfg *rest.Config, scheme *runtime.Scheme, namespace string) (crcache.Cache, error) {
+	return crcache.New(cfg, crcache.Options{
+		Scheme:            scheme,
+		DefaultNamespaces: map[string]crcache.Config{namespace: {}},
+		DefaultTransform:  crcache.TransformStripManagedFields(),
+	})
+}
+
 func NewGCRBACWatchCache(cfg *rest.Config, scheme *runtime.Scheme) (crcache.Cache, error) {
 	gcClusterRBACFieldSelector := fields.OneTermEqualSelector("metadata.name", GCClusterRBACName)
 	return crcache.New(cfg, crcache.Options{
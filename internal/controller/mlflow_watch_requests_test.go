@@ -5,6 +5,8 @@ import (
 	"slices"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -70,3 +72,232 @@ func TestMLflowOperatorToMLflowRequestsIgnoresUnexpectedModuleName(t *testing.T)
 		t.Fatalf("expected no requests for unexpected module name, got %d", len(requests))
 	}
 }
+
+func TestSecretToReferencingMLflowRequests(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := mlflowv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add MLflow scheme: %v", err)
+	}
+
+	byURI := &mlflowv1.MLflow{}
+	byURI.Name = "by-uri"
+	byURI.Spec.BackendStoreURIFrom = &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"},
+		Key:                  "uri",
+	}
+	byEnvFrom := &mlflowv1.MLflow{}
+	byEnvFrom.Name = "by-env-from"
+	byEnvFrom.Spec.EnvFrom = []corev1.EnvFromSource{{
+		SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}},
+	}}
+	byPassword := &mlflowv1.MLflow{}
+	byPassword.Name = "by-password"
+	byPassword.Spec.Database = &mlflowv1.DatabaseSpec{Connection: &mlflowv1.DatabaseConnectionSpec{
+		Host:     "db",
+		Database: "mlflow",
+		User:     "mlflow",
+		PasswordSecret: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"},
+			Key:                  "password",
+		},
+	}}
+
+	byS3Credentials := &mlflowv1.MLflow{}
+	byS3Credentials.Name = "by-s3-credentials"
+	byS3Credentials.Spec.ArtifactStore = &mlflowv1.ArtifactStoreSpec{S3: &mlflowv1.S3ArtifactStoreSpec{
+		AccessKeyIDSecret: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "s3-credentials"},
+			Key:                  "AWS_ACCESS_KEY_ID",
+		},
+		SecretAccessKeySecret: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "s3-credentials"},
+			Key:                  "AWS_SECRET_ACCESS_KEY",
+		},
+		SessionTokenSecret: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "s3-session"},
+			Key:                  "AWS_SESSION_TOKEN",
+		},
+	}}
+	byArtifactCA := &mlflowv1.MLflow{}
+	byArtifactCA.Name = "by-artifact-ca"
+	byArtifactCA.Spec.ArtifactStore = &mlflowv1.ArtifactStoreSpec{CABundleSecret: ptr("s3-ca")}
+	byExtraVolume := &mlflowv1.MLflow{}
+	byExtraVolume.Name = "by-extra-volume"
+	byExtraVolume.Spec.ExtraVolumes = []corev1.Volume{
+		{Name: "plugin-config", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "plugin-config"}}},
+		{Name: "bundle", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{
+			Sources: []corev1.VolumeProjection{{Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "s3-ca"},
+			}}},
+		}}},
+	}
+
+	client := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(byURI, byEnvFrom, byPassword, byS3Credentials, byArtifactCA, byExtraVolume).
+		Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme}
+
+	tests := []struct {
+		secret string
+		want   []string
+	}{
+		{secret: "db-credentials", want: []string{"by-password", "by-uri"}},
+		{secret: "aws-credentials", want: []string{"by-env-from"}},
+		{secret: "s3-credentials", want: []string{"by-s3-credentials"}},
+		{secret: "s3-session", want: []string{"by-s3-credentials"}},
+		{secret: "s3-ca", want: []string{"by-artifact-ca", "by-extra-volume"}},
+		{secret: "plugin-config", want: []string{"by-extra-volume"}},
+		{secret: "unrelated"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.secret, func(t *testing.T) {
+			secret := &corev1.Secret{}
+			secret.Name = tt.secret
+			secret.Namespace = "opendatahub"
+
+			var gotNames []string
+			for _, request := range reconciler.secretToReferencingMLflowRequests(context.Background(), secret) {
+				gotNames = append(gotNames, request.Name)
+			}
+			slices.Sort(gotNames)
+			if !slices.Equal(gotNames, tt.want) {
+				t.Fatalf("expected MLflow names %v, got %v", tt.want, gotNames)
+			}
+		})
+	}
+}
+
+func TestConfigMapToReferencingMLflowRequests(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := mlflowv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add MLflow scheme: %v", err)
+	}
+
+	byCABundle := &mlflowv1.MLflow{}
+	byCABundle.Name = "by-ca-bundle"
+	byCABundle.Spec.CABundleConfigMap = &mlflowv1.CABundleConfigMapSpec{Name: "custom-ca"}
+	byEnv := &mlflowv1.MLflow{}
+	byEnv.Name = "by-env"
+	byEnv.Spec.Env = []corev1.EnvVar{{
+		Name: "AWS_DEFAULT_REGION",
+		ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "aws-settings"},
+			Key:                  "region",
+		}},
+	}}
+
+	byExtraVolume := &mlflowv1.MLflow{}
+	byExtraVolume.Name = "by-extra-volume"
+	byExtraVolume.Spec.ExtraVolumes = []corev1.Volume{{
+		Name: "plugins",
+		VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mlflow-plugins"},
+		}},
+	}}
+
+	client := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(byCABundle, byEnv, byExtraVolume).
+		Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme}
+
+	configMap := &corev1.ConfigMap{}
+	configMap.Name = "aws-settings"
+	requests := reconciler.configMapToReferencingMLflowRequests(context.Background(), configMap)
+	if len(requests) != 1 || requests[0].Name != "by-env" {
+		t.Fatalf("expected a single request for by-env, got %v", requests)
+	}
+
+	configMap.Name = "custom-ca"
+	requests = reconciler.configMapToReferencingMLflowRequests(context.Background(), configMap)
+	if len(requests) != 1 || requests[0].Name != "by-ca-bundle" {
+		t.Fatalf("expected a single request for by-ca-bundle, got %v", requests)
+	}
+
+	configMap.Name = "mlflow-plugins"
+	requests = reconciler.configMapToReferencingMLflowRequests(context.Background(), configMap)
+	if len(requests) != 1 || requests[0].Name != "by-extra-volume" {
+		t.Fatalf("expected a single request for by-extra-volume, got %v", requests)
+	}
+}
+
+func TestReferencedDataHash(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	ctx := context.Background()
+
+	mlflow := &mlflowv1.MLflow{}
+	mlflow.Spec.EnvFrom = []corev1.EnvFromSource{
+		{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"}}},
+		{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-settings"}}},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "opendatahub"},
+		Data:       map[string][]byte{"PGPASSWORD": []byte("first")},
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-settings", Namespace: "opendatahub"},
+		Data:       map[string]string{"AWS_DEFAULT_REGION": "us-east-1"},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := &MLflowReconciler{Client: client, Scheme: scheme}
+	hash := func(m *mlflowv1.MLflow) string {
+		t.Helper()
+		got, err := reconciler.referencedDataHash(ctx, m, "opendatahub")
+		if err != nil {
+			t.Fatalf("referencedDataHash: %v", err)
+		}
+		return got
+	}
+
+	if got := hash(&mlflowv1.MLflow{}); got != "" {
+		t.Fatalf("expected no hash without references, got %q", got)
+	}
+
+	missing := hash(mlflow)
+	if missing == "" {
+		t.Fatal("expected a hash for missing referenced objects")
+	}
+	if err := client.Create(ctx, secret); err != nil {
+		t.Fatalf("create Secret: %v", err)
+	}
+	if err := client.Create(ctx, configMap); err != nil {
+		t.Fatalf("create ConfigMap: %v", err)
+	}
+	created := hash(mlflow)
+	if created == missing {
+		t.Fatal("expected the hash to change when the referenced objects are created")
+	}
+	if again := hash(mlflow); again != created {
+		t.Fatalf("expected a stable hash, got %q then %q", created, again)
+	}
+
+	// Metadata-only changes must not roll the pods.
+	secret.Labels = map[string]string{"team": "a"}
+	if err := client.Update(ctx, secret); err != nil {
+		t.Fatalf("update Secret labels: %v", err)
+	}
+	if got := hash(mlflow); got != created {
+		t.Fatal("expected a label change to keep the hash")
+	}
+
+	secret.Data["PGPASSWORD"] = []byte("second")
+	if err := client.Update(ctx, secret); err != nil {
+		t.Fatalf("rotate Secret: %v", err)
+	}
+	rotated := hash(mlflow)
+	if rotated == created {
+		t.Fatal("expected the hash to change when the Secret data is rotated")
+	}
+
+	configMap.Data["AWS_DEFAULT_REGION"] = "eu-west-1"
+	if err := client.Update(ctx, configMap); err != nil {
+		t.Fatalf("update ConfigMap: %v", err)
+	}
+	if got := hash(mlflow); got == rotated {
+		t.Fatal("expected the hash to change when the ConfigMap data changes")
+	}
+}
@@ -252,6 +252,7 @@ func (r *NamespaceRBACReconciler) applyRoleBindings(ctx context.Context, namespa
 		if err := controllerutil.SetControllerReference(mlflow, rb, r.Scheme); err != nil {
 			return fmt.Errorf("failed to set owner reference on RoleBinding %s/%s: %w", rb.Namespace, rb.Name, err)
 		}
+		setSourceAnnotations(mlflow, rb)
 
 		existing := &rbacv1.RoleBinding{}
 		err := r.rbReader(rb.Name).Get(ctx, client.ObjectKeyFromObject(rb), existing)
@@ -0,0 +1,73 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	// pausedAnnotation stops the operator from rendering and applying the
+	// MLflow resources while it is set to "true".
+	pausedAnnotation = "mlflow.opendatahub.io/paused"
+
+	reconcilePausedReason  = "ReconcilePaused"
+	reconcileResumedReason = "ReconcileResumed"
+)
+
+// isReconcilePaused reports whether the paused annotation is set to "true".
+func isReconcilePaused(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Annotations[pausedAnnotation] == "true"
+}
+
+// reconcilePaused records the Paused condition. While the MLflow is paused it
+// persists the status and reports true so the caller skips the rest of the
+// reconcile; managed objects are left as they are, including manual edits.
+// Once the annotation is cleared, the condition turns False and the normal
+// reconcile persists it.
+func (r *MLflowReconciler) reconcilePaused(ctx context.Context, mlflow *mlflowv1.MLflow) (bool, error) {
+	if !isReconcilePaused(mlflow) {
+		if meta.IsStatusConditionTrue(mlflow.Status.Conditions, PausedCondition) {
+			meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+				Type:               PausedCondition,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: mlflow.Generation,
+				Reason:             reconcileResumedReason,
+				Message:            "Reconciliation resumed after the paused annotation was removed",
+			})
+		}
+		return false, nil
+	}
+
+	logf.FromContext(ctx).Info("Reconciliation paused by annotation", "name", mlflow.Name, "annotation", pausedAnnotation)
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               PausedCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             reconcilePausedReason,
+		Message: fmt.Sprintf("Reconciliation is paused by the %s annotation; managed resources are not updated. "+
+			"Remove the annotation to resume.", pausedAnnotation),
+	})
+	return true, r.updateStatus(ctx, mlflow)
+}
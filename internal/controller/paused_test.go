@@ -0,0 +1,140 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func pausedTestMLflow() *mlflowv1.MLflow {
+	return &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "mlflow",
+			Generation:  3,
+			Annotations: map[string]string{pausedAnnotation: "true"},
+		},
+		Spec: mlflowv1.MLflowSpec{BackendStoreURI: ptr(testBackendStoreURI)},
+	}
+}
+
+func TestReconcile_PausedSkipsRendering(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	scheme := newTestScheme(t)
+	g.Expect(appsv1.AddToScheme(scheme)).To(gomega.Succeed())
+	reconciler := &MLflowReconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&mlflowv1.MLflow{}).
+			WithObjects(pausedTestMLflow()).
+			Build(),
+		Scheme:    scheme,
+		Namespace: "test-ns",
+		// An unusable chart path makes any rendering attempt fail the reconcile.
+		ChartPath: "does-not-exist",
+	}
+
+	result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "mlflow"}})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(ctrl.Result{}))
+
+	deployments := &appsv1.DeploymentList{}
+	g.Expect(reconciler.List(ctx, deployments)).To(gomega.Succeed())
+	g.Expect(deployments.Items).To(gomega.BeEmpty())
+
+	condition := meta.FindStatusCondition(persistedConditions(t, reconciler), PausedCondition)
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(gomega.Equal(reconcilePausedReason))
+	g.Expect(condition.ObservedGeneration).To(gomega.Equal(int64(3)))
+}
+
+func TestReconcilePaused_ConditionToggle(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		conditions  []metav1.Condition
+		wantPaused  bool
+		wantStatus  metav1.ConditionStatus
+		wantReason  string
+	}{
+		{
+			name:        "paused",
+			annotations: map[string]string{pausedAnnotation: "true"},
+			wantPaused:  true,
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  reconcilePausedReason,
+		},
+		{
+			name:        "only true pauses",
+			annotations: map[string]string{pausedAnnotation: "false"},
+		},
+		{
+			name: "resumed after the annotation is cleared",
+			conditions: []metav1.Condition{{
+				Type:   PausedCondition,
+				Status: metav1.ConditionTrue,
+				Reason: reconcilePausedReason,
+			}},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: reconcileResumedReason,
+		},
+		{
+			name: "never paused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mlflow := pausedTestMLflow()
+			mlflow.Annotations = tt.annotations
+			mlflow.Status.Conditions = tt.conditions
+			reconciler := &MLflowReconciler{
+				Client: fake.NewClientBuilder().
+					WithScheme(newTestScheme(t)).
+					WithStatusSubresource(&mlflowv1.MLflow{}).
+					WithObjects(mlflow.DeepCopy()).
+					Build(),
+			}
+
+			paused, err := reconciler.reconcilePaused(context.Background(), mlflow)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(paused).To(gomega.Equal(tt.wantPaused))
+
+			condition := meta.FindStatusCondition(mlflow.Status.Conditions, PausedCondition)
+			if tt.wantReason == "" {
+				g.Expect(condition).To(gomega.BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(gomega.BeNil())
+			g.Expect(condition.Status).To(gomega.Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(gomega.Equal(tt.wantReason))
+		})
+	}
+}
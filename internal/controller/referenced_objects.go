@@ -0,0 +1,270 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+// referencedSecretNames returns the names of the Secrets an MLflow spec reads
+// from: store URIs, the artifacts destination, the database password, the S3
+// credentials and artifact store CA, env/envFrom sources, and extra volumes.
+func referencedSecretNames(mlflow *mlflowv1.MLflow) sets.Set[string] {
+	names := sets.New[string]()
+	spec := &mlflow.Spec
+	if spec.BackendStoreURIFrom != nil {
+		names.Insert(spec.BackendStoreURIFrom.Name)
+	}
+	if spec.ReadReplicaBackendStoreURIFrom != nil {
+		names.Insert(spec.ReadReplicaBackendStoreURIFrom.Name)
+	}
+	if spec.RegistryStoreURIFrom != nil {
+		names.Insert(spec.RegistryStoreURIFrom.Name)
+	}
+	if spec.ArtifactsDestinationFrom != nil {
+		names.Insert(spec.ArtifactsDestinationFrom.Name)
+	}
+	if spec.Database != nil && spec.Database.Connection != nil && spec.Database.Connection.PasswordSecret != nil {
+		names.Insert(spec.Database.Connection.PasswordSecret.Name)
+	}
+	if store := spec.ArtifactStore; store != nil {
+		if store.CABundleSecret != nil {
+			names.Insert(*store.CABundleSecret)
+		}
+		if s3 := store.S3; s3 != nil {
+			for _, selector := range []*corev1.SecretKeySelector{
+				s3.AccessKeyIDSecret, s3.SecretAccessKeySecret, s3.SessionTokenSecret,
+			} {
+				if selector != nil {
+					names.Insert(selector.Name)
+				}
+			}
+		}
+	}
+	for _, env := range spec.Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+			names.Insert(env.ValueFrom.SecretKeyRef.Name)
+		}
+	}
+	for _, envFrom := range spec.EnvFrom {
+		if envFrom.SecretRef != nil {
+			names.Insert(envFrom.SecretRef.Name)
+		}
+	}
+	for _, volume := range spec.ExtraVolumes {
+		if volume.Secret != nil {
+			names.Insert(volume.Secret.SecretName)
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil {
+					names.Insert(source.Secret.Name)
+				}
+			}
+		}
+	}
+	names.Delete("")
+	return names
+}
+
+// referencedConfigMapNames returns the names of the ConfigMaps an MLflow spec
+// reads from: the custom CA bundle, env/envFrom sources, and extra volumes.
+func referencedConfigMapNames(mlflow *mlflowv1.MLflow) sets.Set[string] {
+	names := sets.New[string]()
+	spec := &mlflow.Spec
+	if spec.CABundleConfigMap != nil {
+		names.Insert(spec.CABundleConfigMap.Name)
+	}
+	for _, env := range spec.Env {
+		if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil {
+			names.Insert(env.ValueFrom.ConfigMapKeyRef.Name)
+		}
+	}
+	for _, envFrom := range spec.EnvFrom {
+		if envFrom.ConfigMapRef != nil {
+			names.Insert(envFrom.ConfigMapRef.Name)
+		}
+	}
+	for _, volume := range spec.ExtraVolumes {
+		if volume.ConfigMap != nil {
+			names.Insert(volume.ConfigMap.Name)
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					names.Insert(source.ConfigMap.Name)
+				}
+			}
+		}
+	}
+	names.Delete("")
+	return names
+}
+
+// referencedDataHashAnnotation is the pod annotation holding a hash of the
+// data of the Secrets and ConfigMaps the spec references. Environment
+// variables are read at container start, so the pods must roll for a rotated
+// credential to take effect.
+const referencedDataHashAnnotation = "mlflow.opendatahub.io/referenced-data-hash"
+
+// referencedObjectMetadata returns an empty metadata-only object of the given
+// kind, for watching through ReferencedObjectWatchCache.
+func referencedObjectMetadata(gvk schema.GroupVersionKind) *metav1.PartialObjectMetadata {
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+// secretMetadataToReferencingMLflowRequests maps a Secret metadata event from
+// ReferencedObjectWatchCache to the MLflow instances that reference it.
+func (r *MLflowReconciler) secretMetadataToReferencingMLflowRequests(ctx context.Context, obj *metav1.PartialObjectMetadata) []reconcile.Request {
+	return r.secretToReferencingMLflowRequests(ctx, obj)
+}
+
+// configMapMetadataToReferencingMLflowRequests maps a ConfigMap metadata event
+// from ReferencedObjectWatchCache to the MLflow instances that reference it.
+func (r *MLflowReconciler) configMapMetadataToReferencingMLflowRequests(ctx context.Context, obj *metav1.PartialObjectMetadata) []reconcile.Request {
+	return r.configMapToReferencingMLflowRequests(ctx, obj)
+}
+
+// secretToReferencingMLflowRequests enqueues the MLflow instances whose spec
+// references the Secret.
+func (r *MLflowReconciler) secretToReferencingMLflowRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.referencingMLflowRequests(ctx, obj, "Secret", referencedSecretNames)
+}
+
+// configMapToReferencingMLflowRequests enqueues the MLflow instances whose
+// spec references the ConfigMap.
+func (r *MLflowReconciler) configMapToReferencingMLflowRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.referencingMLflowRequests(ctx, obj, "ConfigMap", referencedConfigMapNames)
+}
+
+// referencedObjectReader returns the reader for referenced Secrets and
+// ConfigMaps. The manager cache only holds Secrets labelled app=mlflow, so
+// user Secrets are read from the API server.
+func (r *MLflowReconciler) referencedObjectReader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+	return r.Client
+}
+
+// referencedDataHash returns a hash of the data of the Secrets and ConfigMaps
+// mlflow references in namespace, or "" when it references none. A missing
+// object hashes differently from an empty one, so creating it rolls the pods.
+func (r *MLflowReconciler) referencedDataHash(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) (string, error) {
+	secretNames := sets.List(referencedSecretNames(mlflow))
+	configMapNames := sets.List(referencedConfigMapNames(mlflow))
+	if len(secretNames) == 0 && len(configMapNames) == 0 {
+		return "", nil
+	}
+
+	reader := r.referencedObjectReader()
+	// A nil entry marks a missing object. encoding/json sorts map keys and
+	// base64-encodes the values, so the encoding is stable and lossless.
+	data := map[string]map[string][]byte{}
+	for _, name := range secretNames {
+		secret := &corev1.Secret{}
+		err := reader.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret)
+		if errors.IsNotFound(err) {
+			data["Secret/"+name] = nil
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("get Secret %s: %w", name, err)
+		}
+		entry := make(map[string][]byte, len(secret.Data))
+		for key, value := range secret.Data {
+			entry[key] = value
+		}
+		data["Secret/"+name] = entry
+	}
+	for _, name := range configMapNames {
+		configMap := &corev1.ConfigMap{}
+		err := reader.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, configMap)
+		if errors.IsNotFound(err) {
+			data["ConfigMap/"+name] = nil
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("get ConfigMap %s: %w", name, err)
+		}
+		entry := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+		for key, value := range configMap.Data {
+			entry[key] = []byte(value)
+		}
+		for key, value := range configMap.BinaryData {
+			entry[key] = value
+		}
+		data["ConfigMap/"+name] = entry
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (r *MLflowReconciler) referencingMLflowRequests(
+	ctx context.Context,
+	obj client.Object,
+	kind string,
+	referencedNames func(*mlflowv1.MLflow) sets.Set[string],
+) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	mlflowList := &mlflowv1.MLflowList{}
+	if err := r.List(ctx, mlflowList); err != nil {
+		log.Error(err, "Failed to list MLflow instances for referenced object watch", "kind", kind)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range mlflowList.Items {
+		mlflow := &mlflowList.Items[i]
+		if !referencedNames(mlflow).Has(obj.GetName()) {
+			continue
+		}
+		log.V(1).Info("Enqueueing MLflow reconciliation due to referenced object change",
+			"mlflow", mlflow.Name,
+			"kind", kind,
+			"name", obj.GetName(),
+			"namespace", obj.GetNamespace())
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: mlflow.Name},
+		})
+	}
+	return requests
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+const (
+	// renderOnlyAnnotation makes the operator render the MLflow resources
+	// into a preview ConfigMap instead of applying them while it is set to
+	// "true".
+	renderOnlyAnnotation = "mlflow.opendatahub.io/render-only"
+
+	renderPreviewConfigMapName = "mlflow-render-preview"
+	renderPreviewKey           = "manifests.yaml"
+
+	renderOnlyReason        = "RenderOnly"
+	renderOnlyFailedReason  = "RenderFailed"
+	renderOnlyResumedReason = "ApplyResumed"
+)
+
+// isRenderOnly reports whether the render-only annotation is set to "true".
+func isRenderOnly(mlflow *mlflowv1.MLflow) bool {
+	return mlflow.Annotations[renderOnlyAnnotation] == "true"
+}
+
+// reconcileRenderOnly handles the render-only annotation. While it is set, it
+// renders the chart through the same path as a normal reconcile, including
+// the post-render changes made before applying, writes the result to the
+// preview ConfigMap, and reports true so the caller skips the rest of the
+// reconcile: nothing is applied, deleted or migrated. Once the annotation is
+// cleared, the condition turns False and the normal reconcile deletes the
+// preview and persists the status.
+func (r *MLflowReconciler) reconcileRenderOnly(ctx context.Context, mlflow *mlflowv1.MLflow, cfg *config.OperatorConfig) (bool, error) {
+	if !isRenderOnly(mlflow) {
+		if meta.IsStatusConditionTrue(mlflow.Status.Conditions, RenderOnlyCondition) {
+			meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+				Type:               RenderOnlyCondition,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: mlflow.Generation,
+				Reason:             renderOnlyResumedReason,
+				Message:            "Resources are applied again after the render-only annotation was removed",
+			})
+		}
+		return false, nil
+	}
+
+	log := logf.FromContext(ctx)
+	namespace := cfg.ApplicationsNamespace
+	objects, err := r.renderPreviewObjects(ctx, mlflow, namespace, cfg)
+	if err != nil {
+		meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+			Type:               RenderOnlyCondition,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: mlflow.Generation,
+			Reason:             renderOnlyFailedReason,
+			Message:            fmt.Sprintf("Failed to render the MLflow resources: %v", err),
+		})
+		if statusErr := r.updateStatus(ctx, mlflow); statusErr != nil {
+			log.Error(statusErr, "Failed to update MLflow status after retries")
+		}
+		return true, err
+	}
+
+	preview, err := renderPreviewConfigMap(mlflow, namespace, objects)
+	if err != nil {
+		return true, err
+	}
+	if err := controllerutil.SetControllerReference(mlflow, preview, r.Scheme); err != nil {
+		return true, fmt.Errorf("set controller reference on ConfigMap %s: %w", preview.Name, err)
+	}
+	setSourceAnnotations(mlflow, preview)
+	if err := r.applyObject(ctx, preview); err != nil {
+		return true, fmt.Errorf("apply ConfigMap %s: %w", preview.Name, err)
+	}
+
+	log.Info("Rendered MLflow resources without applying them", "configMap", preview.Name, "objects", len(objects))
+	meta.SetStatusCondition(&mlflow.Status.Conditions, metav1.Condition{
+		Type:               RenderOnlyCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: mlflow.Generation,
+		Reason:             renderOnlyReason,
+		Message: fmt.Sprintf("The %s annotation is set; %d rendered objects were written to ConfigMap %s/%s "+
+			"and nothing is applied. Remove the annotation to apply them.",
+			renderOnlyAnnotation, len(objects), namespace, preview.Name),
+	})
+	return true, r.updateStatus(ctx, mlflow)
+}
+
+// renderPreviewObjects renders the objects a normal reconcile would apply,
+// with the same render options, unmanaged fields removed and ownership set.
+// It only reads from the cluster.
+func (r *MLflowReconciler) renderPreviewObjects(
+	ctx context.Context,
+	mlflow *mlflowv1.MLflow,
+	namespace string,
+	cfg *config.OperatorConfig,
+) ([]*unstructured.Unstructured, error) {
+	platformCABundleExists := true
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      PlatformTrustedCABundleConfigMapName,
+		Namespace: namespace,
+	}, &corev1.ConfigMap{}); errors.IsNotFound(err) {
+		platformCABundleExists = false
+	} else if err != nil {
+		return nil, fmt.Errorf("check platform CA bundle ConfigMap %s: %w", PlatformTrustedCABundleConfigMapName, err)
+	}
+	resourceBounds, err := r.getContainerResourceBounds(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	observedHosts, err := r.observedHosts(ctx, mlflow, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	referencedDataHash, err := r.referencedDataHash(ctx, mlflow, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	renderOptions := r.renderOptions(platformCABundleExists, resourceBounds, observedHosts, referencedDataHash)
+	objects, err := r.chartRenderer().RenderChart(mlflow, namespace, renderOptions, cfg)
+	if err != nil {
+		return nil, err
+	}
+	objects = withoutUnmanagedFields(mlflow, namespace, objects)
+	for _, obj := range objects {
+		if err := r.setOwnership(ctx, mlflow, obj); err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+// renderPreviewConfigMap builds the ConfigMap that holds the rendered objects
+// as a multi-document YAML stream. Credentials in container URIs are
+// redacted, because the ConfigMap may be readable by more users than the
+// workloads themselves.
+func renderPreviewConfigMap(mlflow *mlflowv1.MLflow, namespace string, objects []*unstructured.Unstructured) (*corev1.ConfigMap, error) {
+	documents := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		obj = obj.DeepCopy()
+		redactContainers(obj)
+		document, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		documents = append(documents, string(document))
+	}
+
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      renderPreviewConfigMapName + getResourceSuffix(mlflow.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app":             ResourceName + getResourceSuffix(mlflow.Name),
+				ManagedByLabelKey: ManagedByLabelValue,
+			},
+		},
+		Data: map[string]string{
+			renderPreviewKey: strings.Join(documents, "---\n"),
+		},
+	}
+	return configMap, nil
+}
+
+// redactContainers redacts URI credentials in the command, args and env
+// values of the containers and init containers of a Deployment or CronJob.
+func redactContainers(obj *unstructured.Unstructured) {
+	var podSpecPath []string
+	switch obj.GetKind() {
+	case "Deployment":
+		podSpecPath = []string{"spec", "template", "spec"}
+	case "CronJob":
+		podSpecPath = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	default:
+		return
+	}
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, found, err := unstructured.NestedSlice(obj.Object, append(podSpecPath, field)...)
+		if err != nil || !found {
+			continue
+		}
+		for _, container := range containers {
+			containerMap, ok := container.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, field := range []string{"command", "args"} {
+				args, _ := containerMap[field].([]interface{})
+				for i, arg := range args {
+					if value, ok := arg.(string); ok {
+						args[i] = redactArg(value)
+					}
+				}
+			}
+			env, _ := containerMap["env"].([]interface{})
+			for _, item := range env {
+				if envVar, ok := item.(map[string]interface{}); ok {
+					if value, ok := envVar["value"].(string); ok {
+						envVar["value"] = redactURI(value)
+					}
+				}
+			}
+		}
+		_ = unstructured.SetNestedSlice(obj.Object, containers, append(podSpecPath, field)...)
+	}
+}
@@ -25,10 +25,14 @@ import (
 	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
 	"github.com/opendatahub-io/mlflow-operator/internal/config"
 	consolev1 "github.com/openshift/api/console/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -39,6 +43,7 @@ var consoleLinkIconSVG []byte
 
 const (
 	ServiceMonitorCRDName = "ServiceMonitor"
+	RouteCRDName          = "Route"
 	MLflowOperatorCRDName = "MLflowOperator"
 	AuthCRDName           = "Auth"
 )
@@ -127,6 +132,32 @@ func IsServiceMonitorAvailable(discoveryClient discovery.DiscoveryInterface) (bo
 	return false, nil
 }
 
+// IsRouteAvailable checks if the OpenShift Route API is available in the cluster using discovery API
+func IsRouteAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	ctx := context.Background()
+	log := logf.FromContext(ctx)
+
+	gv := schema.GroupVersion{Group: "route.openshift.io", Version: "v1"}
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(gv.String())
+	if err != nil {
+		if errors.IsNotFound(err) || discovery.IsGroupDiscoveryFailedError(err) {
+			log.V(1).Info(fmt.Sprintf("%s API not available in cluster", RouteCRDName))
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check for %s availability: %w", RouteCRDName, err)
+	}
+
+	for _, resource := range resourceList.APIResources {
+		if resource.Kind == RouteCRDName {
+			log.V(1).Info(fmt.Sprintf("%s API is available in cluster", RouteCRDName))
+			return true, nil
+		}
+	}
+
+	log.V(1).Info(fmt.Sprintf("%s API not found in resource list", RouteCRDName))
+	return false, nil
+}
+
 // IsMLflowOperatorAvailable checks if the MLflowOperator CRD is available in the cluster using discovery API.
 func IsMLflowOperatorAvailable(discoveryClient discovery.DiscoveryInterface) (bool, error) {
 	ctx := context.Background()
@@ -216,7 +247,7 @@ func (r *MLflowReconciler) reconcileConsoleLink(
 		Spec: consolev1.ConsoleLinkSpec{
 			Link: consolev1.Link{
 				Text: "MLflow",
-				Href: fmt.Sprintf("%s/%s", cfg.MLflowURL, consoleLinkName),
+				Href: cfg.MLflowURL + publicPathPrefix(mlflow),
 			},
 			Location: consolev1.ApplicationMenu,
 			ApplicationMenu: &consolev1.ApplicationMenuSpec{
@@ -230,6 +261,7 @@ func (r *MLflowReconciler) reconcileConsoleLink(
 	if err := controllerutil.SetControllerReference(mlflow, consoleLink, r.Scheme); err != nil {
 		return fmt.Errorf("failed to set controller reference on ConsoleLink: %w", err)
 	}
+	setSourceAnnotations(mlflow, consoleLink)
 
 	// Create or update the ConsoleLink
 	if err := r.applyObject(ctx, consoleLink); err != nil {
@@ -258,9 +290,10 @@ func (r *MLflowReconciler) reconcileHttpRoute(
 	// Determine HttpRoute name and path prefix based on CR name using resource suffix
 	// If CR name is "mlflow", HttpRoute name is "mlflow" and path prefix is "/mlflow"
 	// Otherwise HttpRoute name is "mlflow-${cr_name}" and path prefix is "/mlflow-${cr_name}"
+	// spec.basePath replaces the path prefix so it matches the server's static prefix.
 	suffix := getResourceSuffix(mlflow.Name)
 	httpRouteName := ResourceName + suffix
-	pathPrefix := "/" + ResourceName + suffix
+	pathPrefix := publicPathPrefix(mlflow)
 	v1PathPrefix := pathPrefix + "/v1"
 	replaceV1Prefix := "/v1"
 	serviceName := ResourceName + suffix
@@ -354,6 +387,7 @@ func (r *MLflowReconciler) reconcileHttpRoute(
 	if err := controllerutil.SetControllerReference(mlflow, httpRoute, r.Scheme); err != nil {
 		return fmt.Errorf("failed to set controller reference on HttpRoute: %w", err)
 	}
+	setSourceAnnotations(mlflow, httpRoute)
 
 	// Create or update the HttpRoute
 	if err := r.applyObject(ctx, httpRoute); err != nil {
@@ -364,3 +398,62 @@ func (r *MLflowReconciler) reconcileHttpRoute(
 	log.V(1).Info("Successfully reconciled HttpRoute", "name", httpRouteName, "pathPrefix", pathPrefix)
 	return nil
 }
+
+// observedHosts returns the hostnames and addresses the cluster assigned to
+// the Route, Ingress and LoadBalancer Service of mlflow: the host of each
+// router that admitted the Route, and the load balancer addresses of the
+// Ingress and Service. Objects that do not exist yet are skipped; their
+// status change triggers another reconcile.
+func (r *MLflowReconciler) observedHosts(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) ([]string, error) {
+	key := client.ObjectKey{Name: ResourceName + getResourceSuffix(mlflow.Name), Namespace: namespace}
+	var hosts []string
+
+	if openShift := mlflow.Spec.OpenShift; r.RouteAvailable && openShift != nil && openShift.Route != nil &&
+		openShift.Route.Enabled != nil && *openShift.Route.Enabled {
+		route := &routev1.Route{}
+		if err := r.Get(ctx, key, route); err != nil && !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("get Route %s: %w", key.Name, err)
+		}
+		for _, ingress := range route.Status.Ingress {
+			for _, condition := range ingress.Conditions {
+				if condition.Type == routev1.RouteAdmitted && condition.Status == corev1.ConditionTrue && ingress.Host != "" {
+					hosts = append(hosts, ingress.Host)
+				}
+			}
+		}
+	}
+
+	if ingressSpec := mlflow.Spec.Ingress; ingressSpec != nil && ingressSpec.Enabled != nil && *ingressSpec.Enabled {
+		ingress := &networkingv1.Ingress{}
+		if err := r.Get(ctx, key, ingress); err != nil && !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("get Ingress %s: %w", key.Name, err)
+		}
+		for _, lb := range ingress.Status.LoadBalancer.Ingress {
+			hosts = append(hosts, loadBalancerHosts(lb.Hostname, lb.IP)...)
+		}
+	}
+
+	if serviceSpec := mlflow.Spec.Service; serviceSpec != nil && serviceSpec.Type != nil &&
+		*serviceSpec.Type == corev1.ServiceTypeLoadBalancer {
+		service := &corev1.Service{}
+		if err := r.Get(ctx, key, service); err != nil && !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("get Service %s: %w", key.Name, err)
+		}
+		for _, lb := range service.Status.LoadBalancer.Ingress {
+			hosts = append(hosts, loadBalancerHosts(lb.Hostname, lb.IP)...)
+		}
+	}
+	return hosts, nil
+}
+
+// loadBalancerHosts returns the non-empty hostname and IP of a load balancer
+// ingress point.
+func loadBalancerHosts(hostname, ip string) []string {
+	var hosts []string
+	for _, host := range []string{hostname, ip} {
+		if host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
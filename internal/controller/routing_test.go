@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	consolev1 "github.com/openshift/api/console/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+	"github.com/opendatahub-io/mlflow-operator/internal/config"
+)
+
+func TestReconcileConsoleLink_Href(t *testing.T) {
+	tests := []struct {
+		name     string
+		mlflow   *mlflowv1.MLflow
+		linkName string
+		wantHref string
+	}{
+		{
+			name:     "default prefix",
+			mlflow:   &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}},
+			linkName: "mlflow",
+			wantHref: "https://gateway.example.com/mlflow",
+		},
+		{
+			name:     "default prefix with resource suffix",
+			mlflow:   &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+			linkName: "mlflow-team-a",
+			wantHref: "https://gateway.example.com/mlflow-team-a",
+		},
+		{
+			name: "base path",
+			mlflow: &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+				Spec:       mlflowv1.MLflowSpec{BasePath: ptr("/tracking/team-a")},
+			},
+			linkName: "mlflow-team-a",
+			wantHref: "https://gateway.example.com/tracking/team-a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+			g.Expect(mlflowv1.AddToScheme(scheme)).To(gomega.Succeed())
+			g.Expect(consolev1.AddToScheme(scheme)).To(gomega.Succeed())
+			tt.mlflow.UID = "mlflow-uid"
+			r := &MLflowReconciler{
+				Client:               fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.mlflow).Build(),
+				Scheme:               scheme,
+				ConsoleLinkAvailable: true,
+			}
+
+			cfg := &config.OperatorConfig{MLflowURL: "https://gateway.example.com", SectionTitle: "OpenShift Self Managed Services"}
+			g.Expect(r.reconcileConsoleLink(ctx, tt.mlflow, cfg)).To(gomega.Succeed())
+
+			link := &consolev1.ConsoleLink{}
+			g.Expect(r.Get(ctx, client.ObjectKey{Name: tt.linkName}, link)).To(gomega.Succeed())
+			g.Expect(link.Spec.Href).To(gomega.Equal(tt.wantHref))
+		})
+	}
+}
+
+func TestObservedHosts(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(routev1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	objectMeta := metav1.ObjectMeta{Name: "mlflow", Namespace: "test-ns"}
+	route := &routev1.Route{
+		ObjectMeta: objectMeta,
+		Status: routev1.RouteStatus{Ingress: []routev1.RouteIngress{
+			{
+				Host:       "mlflow-test-ns.apps.example.com",
+				Conditions: []routev1.RouteIngressCondition{{Type: routev1.RouteAdmitted, Status: corev1.ConditionTrue}},
+			},
+			{
+				Host:       "mlflow-test-ns.rejected.example.com",
+				Conditions: []routev1.RouteIngressCondition{{Type: routev1.RouteAdmitted, Status: corev1.ConditionFalse}},
+			},
+		}},
+	}
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: objectMeta,
+		Status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+			Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: "203.0.113.7"}},
+		}},
+	}
+	service := &corev1.Service{
+		ObjectMeta: objectMeta,
+		Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+			Ingress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}},
+		}},
+	}
+	r := &MLflowReconciler{
+		Client:         fake.NewClientBuilder().WithScheme(scheme).WithObjects(route, ingress, service).Build(),
+		Scheme:         scheme,
+		RouteAvailable: true,
+	}
+
+	mlflow := &mlflowv1.MLflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlflow"},
+		Spec: mlflowv1.MLflowSpec{
+			OpenShift: &mlflowv1.OpenShiftSpec{Route: &mlflowv1.RouteSpec{Enabled: ptr(true)}},
+			Ingress:   &mlflowv1.IngressConfig{Enabled: ptr(true)},
+			Service:   &mlflowv1.ServiceSpec{Type: ptr(corev1.ServiceTypeLoadBalancer)},
+		},
+	}
+	hosts, err := r.observedHosts(ctx, mlflow, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(hosts).To(gomega.ConsistOf("mlflow-test-ns.apps.example.com", "203.0.113.7", "lb.example.com"))
+
+	// Disabled features contribute nothing, even when the objects still exist.
+	hosts, err = r.observedHosts(ctx, &mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: "mlflow"}}, "test-ns")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(hosts).To(gomega.BeEmpty())
+}
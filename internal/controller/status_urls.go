@@ -10,31 +10,31 @@ import (
 
 const mlflowServicePort = 8443
 
-func buildStatusURL(mlflowName, baseURL string, baseURLConfigured bool) string {
+func buildStatusURL(pathPrefix, baseURL string, baseURLConfigured bool) string {
 	baseURL = strings.TrimRight(baseURL, "/")
 	if baseURL == "" || !baseURLConfigured {
 		return ""
 	}
 
-	return fmt.Sprintf("%s/%s%s", baseURL, ResourceName, getResourceSuffix(mlflowName))
+	return baseURL + pathPrefix
 }
 
-func buildStatusAddress(mlflowName, namespace string) *mlflowv1.MLflowAddressStatus {
+func buildStatusAddress(mlflowName, namespace, basePath string) *mlflowv1.MLflowAddressStatus {
 	if namespace == "" {
 		return nil
 	}
 
 	serviceName := ResourceName + getResourceSuffix(mlflowName)
 	return &mlflowv1.MLflowAddressStatus{
-		URL: fmt.Sprintf("https://%s.%s.svc:%d%s", serviceName, namespace, mlflowServicePort, StaticPrefix),
+		URL: fmt.Sprintf("https://%s.%s.svc:%d%s", serviceName, namespace, mlflowServicePort, basePath),
 	}
 }
 
 func setObservedURLs(mlflow *mlflowv1.MLflow, namespace string, publicRouteAvailable bool, cfg *config.OperatorConfig) {
-	mlflow.Status.Address = buildStatusAddress(mlflow.Name, namespace)
+	mlflow.Status.Address = buildStatusAddress(mlflow.Name, namespace, mlflowBasePath(mlflow))
 
 	if publicRouteAvailable && cfg != nil {
-		mlflow.Status.URL = buildStatusURL(mlflow.Name, cfg.MLflowURL, cfg.MLflowURLConfigured)
+		mlflow.Status.URL = buildStatusURL(publicPathPrefix(mlflow), cfg.MLflowURL, cfg.MLflowURLConfigured)
 	} else {
 		mlflow.Status.URL = ""
 	}
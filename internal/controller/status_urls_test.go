@@ -70,7 +70,7 @@ func TestBuildStatusURL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildStatusURL(tt.mlflowName, tt.baseURL, tt.configured)
+			got := buildStatusURL(publicPathPrefix(&mlflowv1.MLflow{ObjectMeta: metav1.ObjectMeta{Name: tt.mlflowName}}), tt.baseURL, tt.configured)
 			if got != tt.want {
 				t.Fatalf("buildStatusURL() = %q, want %q", got, tt.want)
 			}
@@ -108,7 +108,7 @@ func TestBuildStatusAddress(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildStatusAddress(tt.mlflowName, tt.namespace)
+			got := buildStatusAddress(tt.mlflowName, tt.namespace, StaticPrefix)
 			if tt.wantNil {
 				if got != nil {
 					t.Fatalf("buildStatusAddress() = %#v, want nil", got)
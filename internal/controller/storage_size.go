@@ -0,0 +1,194 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+const (
+	storageSizeOutOfRangeReason = "StorageSizeOutOfRange"
+
+	// StorageClasses have no standard field for the volume sizes their
+	// provisioner accepts. Cluster admins can publish the range with these
+	// annotations so a request outside it is reported instead of leaving
+	// the PVC Pending.
+	minStorageSizeAnnotation = "mlflow.opendatahub.io/min-storage-size"
+	maxStorageSizeAnnotation = "mlflow.opendatahub.io/max-storage-size"
+
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
+// storageSizeBound is a minimum or maximum PVC size and where it comes from.
+type storageSizeBound struct {
+	Source   string
+	Min, Max *resource.Quantity
+}
+
+// requestedStorageSize returns the size of the operator-managed PVC. It
+// reports false when no PVC is rendered.
+func requestedStorageSize(mlflow *mlflowv1.MLflow) (resource.Quantity, bool) {
+	if mlflow.Spec.Storage == nil || mlflow.Spec.ExistingStorageClaim != nil {
+		return resource.Quantity{}, false
+	}
+	if size, ok := mlflow.Spec.Storage.Resources.Requests[corev1.ResourceStorage]; ok {
+		return size, true
+	}
+	return resource.MustParse(defaultStorageSize), true
+}
+
+// getStorageSizeBounds collects the PVC size ranges that apply to the MLflow
+// PVC: PersistentVolumeClaim limits of the namespace LimitRanges, and the
+// size annotations of its StorageClass, or of the default StorageClass when
+// spec.storage.storageClassName is unset. A StorageClass that cannot be
+// found or does not carry the annotations adds no bound. Once the PVC exists
+// it was admitted and its spec is never updated, so no bounds are returned.
+func (r *MLflowReconciler) getStorageSizeBounds(ctx context.Context, mlflow *mlflowv1.MLflow, namespace string) ([]storageSizeBound, error) {
+	if _, ok := requestedStorageSize(mlflow); !ok {
+		return nil, nil
+	}
+	claimName := storageClaimName(mlflow)
+	err := r.Get(ctx, client.ObjectKey{Name: claimName, Namespace: namespace}, &corev1.PersistentVolumeClaim{})
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("get PersistentVolumeClaim %s: %w", claimName, err)
+	}
+
+	var bounds []storageSizeBound
+	limitRanges := &corev1.LimitRangeList{}
+	if err := r.List(ctx, limitRanges, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("list LimitRanges in %s: %w", namespace, err)
+	}
+	for _, limitRange := range limitRanges.Items {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != corev1.LimitTypePersistentVolumeClaim {
+				continue
+			}
+			bound := storageSizeBound{Source: fmt.Sprintf("LimitRange %s", limitRange.Name)}
+			if minimum, ok := item.Min[corev1.ResourceStorage]; ok {
+				bound.Min = &minimum
+			}
+			if maximum, ok := item.Max[corev1.ResourceStorage]; ok {
+				bound.Max = &maximum
+			}
+			if bound.Min != nil || bound.Max != nil {
+				bounds = append(bounds, bound)
+			}
+		}
+	}
+
+	storageClass, err := r.getStorageClass(ctx, mlflow.Spec.Storage.StorageClassName)
+	if err != nil {
+		return nil, err
+	}
+	if storageClass != nil {
+		bound := storageSizeBound{Source: fmt.Sprintf("StorageClass %s", storageClass.Name)}
+		if minimum, err := resource.ParseQuantity(storageClass.Annotations[minStorageSizeAnnotation]); err == nil {
+			bound.Min = &minimum
+		}
+		if maximum, err := resource.ParseQuantity(storageClass.Annotations[maxStorageSizeAnnotation]); err == nil {
+			bound.Max = &maximum
+		}
+		if bound.Min != nil || bound.Max != nil {
+			bounds = append(bounds, bound)
+		}
+	}
+	return bounds, nil
+}
+
+// storageClaimName returns the name of the operator-managed PVC, matching the
+// mlflow.storageClaimName chart helper.
+func storageClaimName(mlflow *mlflowv1.MLflow) string {
+	if mlflow.Spec.StorageClaimName != nil {
+		return *mlflow.Spec.StorageClaimName
+	}
+	return "mlflow-pvc" + getResourceSuffix(mlflow.Name)
+}
+
+// getStorageClass returns the named StorageClass, or the default one when
+// name is unset or empty, because the chart omits an empty storageClassName
+// from the PVC. It returns nil when the StorageClass does not exist.
+func (r *MLflowReconciler) getStorageClass(ctx context.Context, name *string) (*storagev1.StorageClass, error) {
+	if name != nil && *name != "" {
+		storageClass := &storagev1.StorageClass{}
+		if err := r.Get(ctx, client.ObjectKey{Name: *name}, storageClass); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("get StorageClass %s: %w", *name, err)
+		}
+		return storageClass, nil
+	}
+
+	storageClasses := &storagev1.StorageClassList{}
+	if err := r.List(ctx, storageClasses); err != nil {
+		return nil, fmt.Errorf("list StorageClasses: %w", err)
+	}
+	for i := range storageClasses.Items {
+		if storageClasses.Items[i].Annotations[defaultStorageClassAnnotation] == "true" {
+			return &storageClasses.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// storageSizeViolations lists the bounds the requested PVC size falls outside.
+func storageSizeViolations(mlflow *mlflowv1.MLflow, bounds []storageSizeBound) []string {
+	size, ok := requestedStorageSize(mlflow)
+	if !ok {
+		return nil
+	}
+	var violations []string
+	for _, bound := range bounds {
+		if bound.Min != nil && size.Cmp(*bound.Min) < 0 {
+			violations = append(violations, fmt.Sprintf("%s is below the minimum %s of %s",
+				size.String(), bound.Min.String(), bound.Source))
+		}
+		if bound.Max != nil && size.Cmp(*bound.Max) > 0 {
+			violations = append(violations, fmt.Sprintf("%s is above the maximum %s of %s",
+				size.String(), bound.Max.String(), bound.Source))
+		}
+	}
+	return violations
+}
+
+// storageSizeCause returns the Degraded cause when the requested PVC size is
+// outside a known range, or nil.
+func storageSizeCause(mlflow *mlflowv1.MLflow, bounds []storageSizeBound) *degradedCause {
+	violations := storageSizeViolations(mlflow, bounds)
+	if len(violations) == 0 {
+		return nil
+	}
+	return &degradedCause{
+		reason: storageSizeOutOfRangeReason,
+		message: fmt.Sprintf("The requested MLflow storage size is outside the allowed range: %s. "+
+			"The PVC will be rejected or stay Pending; adjust spec.storage.resources.requests.storage.",
+			strings.Join(violations, "; ")),
+	}
+}
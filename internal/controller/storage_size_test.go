@@ -0,0 +1,151 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mlflowv1 "github.com/opendatahub-io/mlflow-operator/api/v1"
+)
+
+func testStorageClass(name string, annotations map[string]string) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: name, Annotations: annotations},
+		Provisioner: "example.com/csi",
+	}
+}
+
+func TestSetStorageSizeCondition(t *testing.T) {
+	storage := func(size string, storageClassName *string) *corev1.PersistentVolumeClaimSpec {
+		spec := &corev1.PersistentVolumeClaimSpec{StorageClassName: storageClassName}
+		if size != "" {
+			spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)}
+		}
+		return spec
+	}
+	sizedClass := testStorageClass("fast", map[string]string{
+		minStorageSizeAnnotation: "10Gi",
+		maxStorageSizeAnnotation: "1Ti",
+	})
+	defaultClass := testStorageClass("standard", map[string]string{
+		defaultStorageClassAnnotation: "true",
+		minStorageSizeAnnotation:      "5Gi",
+	})
+
+	tests := []struct {
+		name         string
+		spec         mlflowv1.MLflowSpec
+		objects      []client.Object
+		wantMessages []string
+	}{
+		{
+			name:    "no storage",
+			objects: []client.Object{sizedClass, defaultClass},
+		},
+		{
+			name:    "size within the StorageClass range",
+			spec:    mlflowv1.MLflowSpec{Storage: storage("20Gi", ptr("fast"))},
+			objects: []client.Object{sizedClass},
+		},
+		{
+			name:         "size below the StorageClass minimum",
+			spec:         mlflowv1.MLflowSpec{Storage: storage("2Gi", ptr("fast"))},
+			objects:      []client.Object{sizedClass},
+			wantMessages: []string{"2Gi is below the minimum 10Gi of StorageClass fast"},
+		},
+		{
+			name:         "size above the StorageClass maximum",
+			spec:         mlflowv1.MLflowSpec{Storage: storage("2Ti", ptr("fast"))},
+			objects:      []client.Object{sizedClass},
+			wantMessages: []string{"2Ti is above the maximum 1Ti of StorageClass fast"},
+		},
+		{
+			name:         "default size checked against the default StorageClass",
+			spec:         mlflowv1.MLflowSpec{Storage: storage("", nil)},
+			objects:      []client.Object{sizedClass, defaultClass},
+			wantMessages: []string{"2Gi is below the minimum 5Gi of StorageClass standard"},
+		},
+		{
+			name: "PersistentVolumeClaim LimitRange",
+			spec: mlflowv1.MLflowSpec{Storage: storage("50Gi", ptr("fast"))},
+			objects: []client.Object{
+				sizedClass,
+				testLimitRange("storage", corev1.LimitTypePersistentVolumeClaim, nil,
+					corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("20Gi")}),
+			},
+			wantMessages: []string{"50Gi is above the maximum 20Gi of LimitRange storage"},
+		},
+		{
+			name:    "range is not discoverable",
+			spec:    mlflowv1.MLflowSpec{Storage: storage("1Gi", ptr("missing"))},
+			objects: []client.Object{testStorageClass("plain", nil)},
+		},
+		{
+			name: "created PVC is not checked",
+			spec: mlflowv1.MLflowSpec{Storage: storage("2Gi", ptr("fast"))},
+			objects: []client.Object{
+				sizedClass,
+				&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "mlflow-pvc", Namespace: "test-ns"}},
+			},
+		},
+		{
+			name:    "existing claim is not checked",
+			spec:    mlflowv1.MLflowSpec{Storage: storage("2Gi", ptr("fast")), ExistingStorageClaim: ptr("shared")},
+			objects: []client.Object{sizedClass},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			reconciler := &MLflowReconciler{
+				Client: fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(tt.objects...).Build(),
+			}
+			mlflow := &mlflowv1.MLflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "mlflow", Generation: 3},
+				Spec:       tt.spec,
+			}
+
+			bounds, err := reconciler.getStorageSizeBounds(context.Background(), mlflow, "test-ns")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(setSpecDegradedCondition(mlflow, storageSizeCause(mlflow, bounds))).To(gomega.Equal(len(tt.wantMessages) > 0))
+
+			condition := meta.FindStatusCondition(mlflow.Status.Conditions, degradedConditionType)
+			if len(tt.wantMessages) == 0 {
+				g.Expect(condition).To(gomega.BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(gomega.BeNil())
+			g.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+			g.Expect(condition.Reason).To(gomega.Equal(storageSizeOutOfRangeReason))
+			g.Expect(condition.ObservedGeneration).To(gomega.Equal(int64(3)))
+			for _, message := range tt.wantMessages {
+				g.Expect(condition.Message).To(gomega.ContainSubstring(message))
+			}
+		})
+	}
+}
@@ -86,7 +86,7 @@ for crd_config in "${CRDS[@]}"; do
     fi
     
     echo "Installing $crd_name CRD..."
-    if kubectl apply -f "$crd_source"; then
+    if kubectl apply --server-side --force-conflicts -f "$crd_source"; then
         echo -e "${GREEN}✅ $crd_name CRD installed successfully${NC}"
     else
         echo -e "${RED}❌ $crd_name CRD installation failed${NC}"